@@ -14,7 +14,6 @@ import (
 	azurestackhci_v2024_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01"
 	datadog_v2021_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01"
 	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
-	eventgrid_v2022_06_15 "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15"
 	fluidrelay_2022_05_26 "github.com/hashicorp/go-azure-sdk/resource-manager/fluidrelay/2022-05-26"
 	hdinsight_v2021_06_01 "github.com/hashicorp/go-azure-sdk/resource-manager/hdinsight/2021-06-01"
 	nginx_2024_01_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview"
@@ -208,7 +207,7 @@ type Client struct {
 	DomainServices                    *domainservices.Client
 	Elastic                           *elastic.Client
 	ElasticSan                        *elasticsan.Client
-	EventGrid                         *eventgrid_v2022_06_15.Client
+	EventGrid                         *eventgrid.Client
 	Eventhub                          *eventhub.Client
 	FluidRelay                        *fluidrelay_2022_05_26.Client
 	Frontdoor                         *frontdoor.Client
@@ -536,7 +535,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.MSSQL, err = mssql.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for MSSQL: %+v", err)
 	}
-	client.MSSQLManagedInstance = mssqlmanagedinstance.NewClient(o)
+	if client.MSSQLManagedInstance, err = mssqlmanagedinstance.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for MSSQL Managed Instance: %+v", err)
+	}
 	if client.MySQL, err = mysql.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for MySQL: %+v", err)
 	}
@@ -55,6 +55,7 @@ func Default() UserFeatures {
 			DeleteOSDiskOnDeletion:           true,
 			GracefulShutdown:                 false,
 			SkipShutdownAndForceDelete:       false,
+			DeallocateOnResize:               true,
 		},
 		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
 			ForceDelete:               false,
@@ -75,5 +76,14 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		DataFactory: DataFactoryFeatures{
+			StartTriggersOnCreate: true,
+		},
+		Storage: StorageFeatures{
+			DataPlaneAvailable: true,
+		},
+		RedisCache: RedisCacheFeatures{
+			FlushDataOnDestroy: false,
+		},
 	}
 }
@@ -20,6 +20,9 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	DataFactory              DataFactoryFeatures
+	Storage                  StorageFeatures
+	RedisCache               RedisCacheFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -31,6 +34,7 @@ type VirtualMachineFeatures struct {
 	DeleteOSDiskOnDeletion           bool
 	GracefulShutdown                 bool
 	SkipShutdownAndForceDelete       bool
+	DeallocateOnResize               bool
 }
 
 type VirtualMachineScaleSetFeatures struct {
@@ -52,6 +56,7 @@ type KeyVaultFeatures struct {
 	RecoverSoftDeletedCerts          bool
 	RecoverSoftDeletedSecrets        bool
 	RecoverSoftDeletedHSMKeys        bool
+	WaitForRBACPropagation           bool
 }
 
 type TemplateDeploymentFeatures struct {
@@ -104,3 +109,15 @@ type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
 }
+
+type DataFactoryFeatures struct {
+	StartTriggersOnCreate bool
+}
+
+type StorageFeatures struct {
+	DataPlaneAvailable bool
+}
+
+type RedisCacheFeatures struct {
+	FlushDataOnDestroy bool
+}
@@ -170,6 +170,13 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional:    true,
 						Default:     true,
 					},
+
+					"wait_for_rbac_propagation": {
+						Description: "When enabled the `azurerm_key_vault_certificate`, `azurerm_key_vault_key` and `azurerm_key_vault_secret` resources will wait for access to the Key Vault Data Plane to be authorized before being created, rather than failing whilst newly created Role Assignments propagate",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
 				},
 			},
 		},
@@ -230,6 +237,11 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  false,
 					},
+					"deallocate_on_resize": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
 				},
 			},
 		},
@@ -372,6 +384,51 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"data_factory": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_triggers_on_create": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"storage": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"data_plane_available": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"redis_cache": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"flush_data_on_destroy": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -490,6 +547,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := keyVaultRaw["recover_soft_deleted_hardware_security_module_keys"]; ok {
 				featuresMap.KeyVault.RecoverSoftDeletedHSMKeys = v.(bool)
 			}
+			if v, ok := keyVaultRaw["wait_for_rbac_propagation"]; ok {
+				featuresMap.KeyVault.WaitForRBACPropagation = v.(bool)
+			}
 		}
 	}
 
@@ -529,6 +589,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := virtualMachinesRaw["skip_shutdown_and_force_delete"]; ok {
 				featuresMap.VirtualMachine.SkipShutdownAndForceDelete = v.(bool)
 			}
+			if v, ok := virtualMachinesRaw["deallocate_on_resize"]; ok {
+				featuresMap.VirtualMachine.DeallocateOnResize = v.(bool)
+			}
 		}
 	}
 
@@ -624,5 +687,35 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["data_factory"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			dataFactoryRaw := items[0].(map[string]interface{})
+			if v, ok := dataFactoryRaw["start_triggers_on_create"]; ok {
+				featuresMap.DataFactory.StartTriggersOnCreate = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["storage"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			storageRaw := items[0].(map[string]interface{})
+			if v, ok := storageRaw["data_plane_available"]; ok {
+				featuresMap.Storage.DataPlaneAvailable = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["redis_cache"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			redisCacheRaw := items[0].(map[string]interface{})
+			if v, ok := redisCacheRaw["flush_data_on_destroy"]; ok {
+				featuresMap.RedisCache.FlushDataOnDestroy = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
@@ -47,6 +47,7 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
 					RecoverSoftDeletedHSMKeys:        true,
+					WaitForRBACPropagation:           false,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
@@ -62,6 +63,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateOnResize:               true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ForceDelete:               false,
@@ -88,6 +90,15 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					StartTriggersOnCreate: true,
+				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+				RedisCache: features.RedisCacheFeatures{
+					FlushDataOnDestroy: false,
+				},
 			},
 		},
 		{
@@ -129,6 +140,7 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_key_vaults":                             true,
 							"recover_soft_deleted_secrets":                                true,
 							"recover_soft_deleted_hardware_security_module_keys":          true,
+							"wait_for_rbac_propagation":                                   true,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -172,6 +184,7 @@ func TestExpandFeatures(t *testing.T) {
 							"delete_os_disk_on_deletion":            true,
 							"graceful_shutdown":                     true,
 							"skip_shutdown_and_force_delete":        true,
+							"deallocate_on_resize":                  true,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
@@ -193,6 +206,21 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"start_triggers_on_create": true,
+						},
+					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
+					"redis_cache": []interface{}{
+						map[string]interface{}{
+							"flush_data_on_destroy": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -222,6 +250,7 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
 					RecoverSoftDeletedHSMKeys:        true,
+					WaitForRBACPropagation:           true,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
@@ -246,6 +275,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 true,
 					SkipShutdownAndForceDelete:       true,
+					DeallocateOnResize:               true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ReimageOnManualUpgrade:    true,
@@ -263,6 +293,15 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					StartTriggersOnCreate: true,
+				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+				RedisCache: features.RedisCacheFeatures{
+					FlushDataOnDestroy: true,
+				},
 			},
 		},
 		{
@@ -304,6 +343,7 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_key_vaults":                             false,
 							"recover_soft_deleted_secrets":                                false,
 							"recover_soft_deleted_hardware_security_module_keys":          false,
+							"wait_for_rbac_propagation":                                   false,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -347,6 +387,7 @@ func TestExpandFeatures(t *testing.T) {
 							"delete_os_disk_on_deletion":            false,
 							"graceful_shutdown":                     false,
 							"skip_shutdown_and_force_delete":        false,
+							"deallocate_on_resize":                  false,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
@@ -368,6 +409,21 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"start_triggers_on_create": false,
+						},
+					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
+					"redis_cache": []interface{}{
+						map[string]interface{}{
+							"flush_data_on_destroy": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -397,6 +453,7 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      false,
 					RecoverSoftDeletedSecrets:        false,
 					RecoverSoftDeletedHSMKeys:        false,
+					WaitForRBACPropagation:           false,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
@@ -421,6 +478,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateOnResize:               false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ForceDelete:               false,
@@ -438,6 +496,15 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					StartTriggersOnCreate: false,
+				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
+				RedisCache: features.RedisCacheFeatures{
+					FlushDataOnDestroy: false,
+				},
 			},
 		},
 	}
@@ -748,6 +815,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
 					RecoverSoftDeletedHSMKeys:        true,
+					WaitForRBACPropagation:           false,
 				},
 			},
 		},
@@ -768,6 +836,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_key_vaults":                             true,
 							"recover_soft_deleted_secrets":                                true,
 							"recover_soft_deleted_hardware_security_module_keys":          true,
+							"wait_for_rbac_propagation":                                   true,
 						},
 					},
 				},
@@ -785,6 +854,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
 					RecoverSoftDeletedHSMKeys:        true,
+					WaitForRBACPropagation:           true,
 				},
 			},
 		},
@@ -805,6 +875,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_key_vaults":                             false,
 							"recover_soft_deleted_secrets":                                false,
 							"recover_soft_deleted_hardware_security_module_keys":          false,
+							"wait_for_rbac_propagation":                                   false,
 						},
 					},
 				},
@@ -822,6 +893,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeys:           false,
 					RecoverSoftDeletedSecrets:        false,
 					RecoverSoftDeletedHSMKeys:        false,
+					WaitForRBACPropagation:           false,
 				},
 			},
 		},
@@ -921,6 +993,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateOnResize:               true,
 				},
 			},
 		},
@@ -945,6 +1018,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateOnResize:               true,
 				},
 			},
 		},
@@ -969,6 +1043,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateOnResize:               true,
 				},
 			},
 		},
@@ -992,6 +1067,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 true,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateOnResize:               true,
 				},
 			},
 		},
@@ -1015,6 +1091,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       true,
+					DeallocateOnResize:               true,
 				},
 			},
 		},
@@ -1028,6 +1105,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 							"delete_os_disk_on_deletion":            false,
 							"graceful_shutdown":                     false,
 							"skip_shutdown_and_force_delete":        false,
+							"deallocate_on_resize":                  false,
 						},
 					},
 				},
@@ -1038,6 +1116,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateOnResize:               false,
 				},
 			},
 		},
@@ -1678,3 +1757,198 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesDataFactory(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					StartTriggersOnCreate: true,
+				},
+			},
+		},
+		{
+			Name: "Data Factory Start Triggers On Create Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"start_triggers_on_create": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					StartTriggersOnCreate: true,
+				},
+			},
+		},
+		{
+			Name: "Data Factory Start Triggers On Create Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"start_triggers_on_create": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					StartTriggersOnCreate: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.DataFactory, testCase.Expected.DataFactory) {
+			t.Fatalf("Expected %+v but got %+v", result.DataFactory, testCase.Expected.DataFactory)
+		}
+	}
+}
+
+func TestExpandFeaturesStorage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+			},
+		},
+		{
+			Name: "Storage Data Plane Available Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+			},
+		},
+		{
+			Name: "Storage Data Plane Available Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Storage, testCase.Expected.Storage) {
+			t.Fatalf("Expected %+v but got %+v", result.Storage, testCase.Expected.Storage)
+		}
+	}
+}
+
+func TestExpandFeaturesRedisCache(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"redis_cache": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				RedisCache: features.RedisCacheFeatures{
+					FlushDataOnDestroy: false,
+				},
+			},
+		},
+		{
+			Name: "Redis Cache Flush Data On Destroy Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"redis_cache": []interface{}{
+						map[string]interface{}{
+							"flush_data_on_destroy": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RedisCache: features.RedisCacheFeatures{
+					FlushDataOnDestroy: true,
+				},
+			},
+		},
+		{
+			Name: "Redis Cache Flush Data On Destroy Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"redis_cache": []interface{}{
+						map[string]interface{}{
+							"flush_data_on_destroy": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RedisCache: features.RedisCacheFeatures{
+					FlushDataOnDestroy: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.RedisCache, testCase.Expected.RedisCache) {
+			t.Fatalf("Expected %+v but got %+v", result.RedisCache, testCase.Expected.RedisCache)
+		}
+	}
+}
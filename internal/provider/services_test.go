@@ -75,11 +75,19 @@ func TestUntypedResourcesContainImporters(t *testing.T) {
 			"azurerm_security_center_server_vulnerability_assessment": {},
 			"azurerm_template_deployment":                             {},
 		}
+		// these resources perform a one-off action which isn't retained by the service, so there's nothing to import
+		actionResourcesWhichDontSupportImport := map[string]struct{}{
+			"azurerm_cdn_frontdoor_purge": {},
+		}
 		for k, v := range service.SupportedResources() {
 			if _, ok := deprecatedResourcesWhichDontSupportImport[k]; ok {
 				t.Logf("the resource %q doesn't support import but it's deprecated so we're skipping..", k)
 				continue
 			}
+			if _, ok := actionResourcesWhichDontSupportImport[k]; ok {
+				t.Logf("the resource %q doesn't support import as it performs a one-off action so we're skipping..", k)
+				continue
+			}
 
 			if v.Importer == nil {
 				t.Fatalf("all resources must support import, however the resource %q does not support import", k)
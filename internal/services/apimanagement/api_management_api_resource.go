@@ -4,6 +4,8 @@
 package apimanagement
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -147,9 +149,10 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"content_value": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsNotEmpty,
+							DiffSuppressFunc: apiManagementApiImportContentValueDiffSuppress,
 						},
 
 						"content_format": {
@@ -169,6 +172,11 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 							}, false),
 						},
 
+						"content_hash": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"wsdl_selector": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -409,7 +417,8 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 
 	// If import is used, we need to send properties to Azure API in two operations.
 	// First we execute import and then updated the other props.
-	if vs, hasImport := d.GetOk("import"); hasImport {
+	// Since importing recreates the operations of the API, this is only done when the imported document has changed.
+	if vs, hasImport := d.GetOk("import"); hasImport && apiManagementApiImportRequired(d) {
 		importVs := vs.([]interface{})
 		importV := importVs[0].(map[string]interface{})
 		contentFormat := importV["content_format"].(string)
@@ -453,6 +462,12 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		if err := client.CreateOrUpdateThenPoll(ctx, newId, apiParams, api.CreateOrUpdateOperationOptions{}); err != nil {
 			return fmt.Errorf("creating/updating %s: %+v", id, err)
 		}
+
+		// the imported document isn't returned from the API, so we track a hash of what was last imported
+		importV["content_hash"] = apiManagementApiImportContentHash(contentFormat, contentValue)
+		if err := d.Set("import", []interface{}{importV}); err != nil {
+			return fmt.Errorf("setting `import`: %+v", err)
+		}
 	}
 
 	description := d.Get("description").(string)
@@ -803,3 +818,44 @@ func getApiName(apiId string) string {
 
 	return name
 }
+
+// normalizeApiManagementApiImportContent normalizes the imported document so that changes to formatting alone (such as
+// whitespace, line endings or the ordering of keys within a JSON document) don't cause the API to be re-imported
+func normalizeApiManagementApiImportContent(contentFormat, contentValue string) string {
+	switch api.ContentFormat(contentFormat) {
+	case api.ContentFormatOpenapiPositivejson, api.ContentFormatSwaggerNegativejson:
+		var v interface{}
+		if err := json.Unmarshal([]byte(contentValue), &v); err == nil {
+			if normalized, err := json.Marshal(v); err == nil {
+				return string(normalized)
+			}
+		}
+	}
+
+	return strings.TrimSpace(strings.ReplaceAll(contentValue, "\r\n", "\n"))
+}
+
+func apiManagementApiImportContentHash(contentFormat, contentValue string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(normalizeApiManagementApiImportContent(contentFormat, contentValue))))
+}
+
+// apiManagementApiImportRequired returns whether the API definition needs to be imported, which is the case when the hash
+// of the document differs from the `content_hash` of the document last imported or the way it's imported has changed
+func apiManagementApiImportRequired(d *pluginsdk.ResourceData) bool {
+	if d.IsNewResource() || d.HasChanges("import.0.content_format", "import.0.wsdl_selector") {
+		return true
+	}
+
+	previousContentHash, _ := d.GetChange("import.0.content_hash")
+	contentHash := apiManagementApiImportContentHash(d.Get("import.0.content_format").(string), d.Get("import.0.content_value").(string))
+	return previousContentHash.(string) != contentHash
+}
+
+func apiManagementApiImportContentValueDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	contentFormat := d.Get("import.0.content_format").(string)
+	return normalizeApiManagementApiImportContent(contentFormat, old) == normalizeApiManagementApiImportContent(contentFormat, new)
+}
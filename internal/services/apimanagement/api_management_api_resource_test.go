@@ -270,6 +270,35 @@ func TestAccApiManagementApi_importOpenapi(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApi_importOpenapi31Json(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importOpenapi31Json(data, `file("testdata/api_management_api_openapi31.json")`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("import.0.content_hash").Exists(),
+			),
+		},
+		{
+			// reformatting the document shouldn't cause it to be re-imported
+			Config:   r.importOpenapi31Json(data, `jsonencode(jsondecode(file("testdata/api_management_api_openapi31.json")))`),
+			PlanOnly: true,
+		},
+		{
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateVerifyIgnore: []string{
+				// not returned from the API
+				"import",
+			},
+		},
+	})
+}
+
 func TestAccApiManagementApi_importSwaggerWithServiceUrl(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
 	r := ApiManagementApiResource{}
@@ -688,6 +717,27 @@ resource "azurerm_api_management_api" "test" {
 `, r.template(data, SkuNameConsumption), data.RandomInteger)
 }
 
+func (r ApiManagementApiResource) importOpenapi31Json(data acceptance.TestData, contentValue string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "current"
+
+  import {
+    content_value  = %s
+    content_format = "openapi+json"
+  }
+}
+`, r.template(data, SkuNameConsumption), data.RandomInteger, contentValue)
+}
+
 func (r ApiManagementApiResource) importSwaggerWithServiceUrl(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
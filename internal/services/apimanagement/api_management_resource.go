@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/delegationsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/deletedservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policy"
@@ -28,6 +27,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/signinsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/signupsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tenantaccess"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/apimanagementservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -95,6 +95,21 @@ func resourceApiManagementService() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("virtual_network_configuration", func(ctx context.Context, old, new, meta interface{}) bool {
 				return !(len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0)
 			}),
+
+			// migrating between the classic (v1) and v2 tiers isn't supported in-place
+			pluginsdk.ForceNewIfChange("sku_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				if old.(string) == "" {
+					return false
+				}
+				return apiManagementSkuIsV2(expandAzureRmApiManagementSkuName(old.(string))) != apiManagementSkuIsV2(expandAzureRmApiManagementSkuName(new.(string)))
+			}),
+
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if !d.NewValueKnown("sku_name") {
+					return nil
+				}
+				return validateApiManagementV2Sku(d, expandAzureRmApiManagementSkuName(d.Get("sku_name").(string)))
+			}),
 		),
 	}
 }
@@ -1386,13 +1401,16 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 				return fmt.Errorf("setting `delegation`: %+v", err)
 			}
 
-			tenantAccessServiceId := tenantaccess.NewAccessID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, "access")
-			tenantAccessInformationContract, err := tenantAccessClient.ListSecrets(ctx, tenantAccessServiceId)
-			if err != nil {
-				return fmt.Errorf("retrieving tenant access properties for %s: %+v", *id, err)
-			}
-			if err := d.Set("tenant_access", flattenApiManagementTenantAccessSettings(*tenantAccessInformationContract.Model)); err != nil {
-				return fmt.Errorf("setting `tenant_access`: %+v", err)
+			// the direct management API (and therefore tenant access) isn't available in the v2 tiers
+			if !apiManagementSkuIsV2(model.Sku) {
+				tenantAccessServiceId := tenantaccess.NewAccessID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, "access")
+				tenantAccessInformationContract, err := tenantAccessClient.ListSecrets(ctx, tenantAccessServiceId)
+				if err != nil {
+					return fmt.Errorf("retrieving tenant access properties for %s: %+v", *id, err)
+				}
+				if err := d.Set("tenant_access", flattenApiManagementTenantAccessSettings(*tenantAccessInformationContract.Model)); err != nil {
+					return fmt.Errorf("setting `tenant_access`: %+v", err)
+				}
 			}
 		} else {
 			d.Set("sign_in", []interface{}{})
@@ -1764,6 +1782,45 @@ func expandAzureRmApiManagementSkuName(input string) apimanagementservice.ApiMan
 	}
 }
 
+func apiManagementSkuIsV2(sku apimanagementservice.ApiManagementServiceSkuProperties) bool {
+	return sku.Name == apimanagementservice.SkuTypeBasicVTwo || sku.Name == apimanagementservice.SkuTypeStandardVTwo
+}
+
+// validateApiManagementV2Sku ensures that the blocks which are only supported by the classic tiers aren't used with the v2 tiers,
+// which use a different networking model (outbound VNet integration via a delegated subnet rather than VNet injection)
+func validateApiManagementV2Sku(d *pluginsdk.ResourceDiff, sku apimanagementservice.ApiManagementServiceSkuProperties) error {
+	if !apiManagementSkuIsV2(sku) {
+		return nil
+	}
+
+	if len(d.Get("additional_location").([]interface{})) > 0 {
+		return fmt.Errorf("`additional_location` is not supported when sku type is `%s`", sku.Name)
+	}
+
+	if len(d.Get("zones").(*schema.Set).List()) > 0 {
+		return fmt.Errorf("`zones` is not supported when sku type is `%s`", sku.Name)
+	}
+
+	if d.Get("public_ip_address_id").(string) != "" {
+		return fmt.Errorf("`public_ip_address_id` is not supported when sku type is `%s`", sku.Name)
+	}
+
+	if d.HasChange("tenant_access") && len(d.Get("tenant_access").([]interface{})) > 0 {
+		return fmt.Errorf("`tenant_access` is not supported when sku type is `%s`", sku.Name)
+	}
+
+	switch apimanagementservice.VirtualNetworkType(d.Get("virtual_network_type").(string)) {
+	case apimanagementservice.VirtualNetworkTypeInternal:
+		return fmt.Errorf("`virtual_network_type` must be `%s` or `%s` when sku type is `%s`", apimanagementservice.VirtualNetworkTypeNone, apimanagementservice.VirtualNetworkTypeExternal, sku.Name)
+	case apimanagementservice.VirtualNetworkTypeExternal:
+		if sku.Name != apimanagementservice.SkuTypeStandardVTwo {
+			return fmt.Errorf("`virtual_network_type` must be `%s` when sku type is `%s`", apimanagementservice.VirtualNetworkTypeNone, sku.Name)
+		}
+	}
+
+	return nil
+}
+
 func flattenApiManagementServiceSkuName(input *apimanagementservice.ApiManagementServiceSkuProperties) string {
 	if input == nil {
 		return ""
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceApiModel struct {
+	Name                     string   `tfschema:"name"`
+	ApiManagementWorkspaceId string   `tfschema:"api_management_workspace_id"`
+	DisplayName              string   `tfschema:"display_name"`
+	Path                     string   `tfschema:"path"`
+	Protocols                []string `tfschema:"protocols"`
+	Description              string   `tfschema:"description"`
+	ServiceUrl               string   `tfschema:"service_url"`
+	SubscriptionRequired     bool     `tfschema:"subscription_required"`
+}
+
+type ApiManagementWorkspaceApiResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceApiResource{}
+
+func (r ApiManagementWorkspaceApiResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementApiName(),
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ApiManagementApiPath,
+		},
+
+		"protocols": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(api.PossibleValuesForProtocol(), false),
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceApiResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceApiModel{}
+}
+
+func (r ApiManagementWorkspaceApiResource) ResourceType() string {
+	return "azurerm_api_management_workspace_api"
+}
+
+func (r ApiManagementWorkspaceApiResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return api.ValidateWorkspaceApiID
+}
+
+func (r ApiManagementWorkspaceApiResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := api.NewWorkspaceApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+
+			existing, err := client.WorkspaceApiGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.WorkspaceApiCreateOrUpdateThenPoll(ctx, id, expandApiManagementWorkspaceApi(model), api.WorkspaceApiCreateOrUpdateOperationOptions{}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := api.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceApiGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceApiModel{
+				Name:                     id.ApiId,
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Path = props.Path
+					state.Description = pointer.From(props.Description)
+					state.ServiceUrl = pointer.From(props.ServiceUrl)
+					state.SubscriptionRequired = pointer.From(props.SubscriptionRequired)
+
+					protocols := make([]string, 0)
+					for _, v := range pointer.From(props.Protocols) {
+						protocols = append(protocols, string(v))
+					}
+					state.Protocols = protocols
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := api.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// all of the properties are managed by this resource, so the whole API is sent rather than patching the existing one
+			if err := client.WorkspaceApiCreateOrUpdateThenPoll(ctx, *id, expandApiManagementWorkspaceApi(model), api.WorkspaceApiCreateOrUpdateOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := api.ParseWorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.WorkspaceApiDelete(ctx, *id, api.WorkspaceApiDeleteOperationOptions{DeleteRevisions: pointer.To(true), IfMatch: pointer.To("*")}); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceApi(model ApiManagementWorkspaceApiModel) api.ApiCreateOrUpdateParameter {
+	protocols := make([]api.Protocol, 0)
+	for _, v := range model.Protocols {
+		protocols = append(protocols, api.Protocol(v))
+	}
+
+	parameters := api.ApiCreateOrUpdateParameter{
+		Properties: &api.ApiCreateOrUpdateProperties{
+			DisplayName:          pointer.To(model.DisplayName),
+			Path:                 model.Path,
+			Protocols:            pointer.To(protocols),
+			SubscriptionRequired: pointer.To(model.SubscriptionRequired),
+		},
+	}
+
+	if model.Description != "" {
+		parameters.Properties.Description = pointer.To(model.Description)
+	}
+
+	if model.ServiceUrl != "" {
+		parameters.Properties.ServiceUrl = pointer.To(model.ServiceUrl)
+	}
+
+	return parameters
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/api"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementWorkspaceApiResource struct{}
+
+func TestAccApiManagementWorkspaceApi_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceApiResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := api.ParseWorkspaceApiID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.WorkspaceApiClient.WorkspaceApiGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceApiResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test API"
+  path                        = "test"
+  protocols                   = ["https"]
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceApiResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "import" {
+  name                        = azurerm_api_management_workspace_api.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_api.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_api.test.display_name
+  path                        = azurerm_api_management_workspace_api.test.path
+  protocols                   = azurerm_api_management_workspace_api.test.protocols
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementWorkspaceApiResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Updated Test API"
+  path                        = "updated"
+  protocols                   = ["http", "https"]
+  description                 = "An API used for acceptance testing"
+  service_url                 = "https://example.com/api"
+  subscription_required       = false
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
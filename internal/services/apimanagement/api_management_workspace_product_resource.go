@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/product"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceProductModel struct {
+	Name                     string `tfschema:"name"`
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	DisplayName              string `tfschema:"display_name"`
+	Published                bool   `tfschema:"published"`
+	SubscriptionRequired     bool   `tfschema:"subscription_required"`
+	ApprovalRequired         bool   `tfschema:"approval_required"`
+	SubscriptionsLimit       int64  `tfschema:"subscriptions_limit"`
+	Description              string `tfschema:"description"`
+	Terms                    string `tfschema:"terms"`
+}
+
+type ApiManagementWorkspaceProductResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ApiManagementWorkspaceProductResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApiManagementWorkspaceProductResource{}
+)
+
+func (r ApiManagementWorkspaceProductResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"published": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"approval_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"subscriptions_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"terms": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceProductResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceProductModel{}
+}
+
+func (r ApiManagementWorkspaceProductResource) ResourceType() string {
+	return "azurerm_api_management_workspace_product"
+}
+
+func (r ApiManagementWorkspaceProductResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return product.ValidateWorkspaceProductID
+}
+
+func (r ApiManagementWorkspaceProductResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API rejects `approvalRequired` and `subscriptionsLimit` when `subscriptionRequired` is false
+			if !model.SubscriptionRequired && (model.ApprovalRequired || model.SubscriptionsLimit > 0) {
+				return fmt.Errorf("`approval_required` and `subscriptions_limit` can only be specified when `subscription_required` is `true`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := product.NewWorkspaceProductID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+
+			existing, err := client.WorkspaceProductGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.WorkspaceProductCreateOrUpdate(ctx, id, expandApiManagementWorkspaceProduct(model), product.WorkspaceProductCreateOrUpdateOperationOptions{}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := product.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceProductGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceProductModel{
+				Name:                     id.ProductId,
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = props.DisplayName
+					state.Published = pointer.From(props.State) == product.ProductStatePublished
+					state.SubscriptionRequired = pointer.From(props.SubscriptionRequired)
+					state.ApprovalRequired = pointer.From(props.ApprovalRequired)
+					state.SubscriptionsLimit = pointer.From(props.SubscriptionsLimit)
+					state.Description = pointer.From(props.Description)
+					state.Terms = pointer.From(props.Terms)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := product.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.WorkspaceProductCreateOrUpdate(ctx, *id, expandApiManagementWorkspaceProduct(model), product.WorkspaceProductCreateOrUpdateOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := product.ParseWorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.WorkspaceProductDelete(ctx, *id, product.WorkspaceProductDeleteOperationOptions{DeleteSubscriptions: pointer.To(true), IfMatch: pointer.To("*")}); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceProduct(model ApiManagementWorkspaceProductModel) product.ProductContract {
+	state := product.ProductStateNotPublished
+	if model.Published {
+		state = product.ProductStatePublished
+	}
+
+	parameters := product.ProductContract{
+		Properties: &product.ProductContractProperties{
+			DisplayName:          model.DisplayName,
+			State:                pointer.To(state),
+			SubscriptionRequired: pointer.To(model.SubscriptionRequired),
+		},
+	}
+
+	// these can only be sent when a subscription is required, which is validated at plan time
+	if model.SubscriptionRequired {
+		parameters.Properties.ApprovalRequired = pointer.To(model.ApprovalRequired)
+		if model.SubscriptionsLimit > 0 {
+			parameters.Properties.SubscriptionsLimit = pointer.To(model.SubscriptionsLimit)
+		}
+	}
+
+	if model.Description != "" {
+		parameters.Properties.Description = pointer.To(model.Description)
+	}
+
+	if model.Terms != "" {
+		parameters.Properties.Terms = pointer.To(model.Terms)
+	}
+
+	return parameters
+}
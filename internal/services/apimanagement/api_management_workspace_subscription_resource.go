@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/product"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/subscription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceSubscriptionModel struct {
+	Name                     string `tfschema:"name"`
+	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
+	DisplayName              string `tfschema:"display_name"`
+	ProductId                string `tfschema:"product_id"`
+	ApiId                    string `tfschema:"api_id"`
+	State                    string `tfschema:"state"`
+	AllowTracing             bool   `tfschema:"allow_tracing"`
+	PrimaryKey               string `tfschema:"primary_key"`
+	SecondaryKey             string `tfschema:"secondary_key"`
+}
+
+type ApiManagementWorkspaceSubscriptionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceSubscriptionResource{}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": schemaz.SchemaApiManagementChildName(),
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspace.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"product_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: product.ValidateWorkspaceProductID,
+			ExactlyOneOf: []string{"api_id", "product_id"},
+		},
+
+		"api_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: api.ValidateWorkspaceApiID,
+			ExactlyOneOf: []string{"api_id", "product_id"},
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(subscription.SubscriptionStateSubmitted),
+			ValidateFunc: validation.StringInSlice(subscription.PossibleValuesForSubscriptionState(), false),
+		},
+
+		"allow_tracing": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceSubscriptionModel{}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) ResourceType() string {
+	return "azurerm_api_management_workspace_subscription"
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return subscription.ValidateWorkspaceSubscriptions2ID
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			var model ApiManagementWorkspaceSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspace.ParseWorkspaceID(model.ApiManagementWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := subscription.NewWorkspaceSubscriptions2ID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceId, model.Name)
+
+			existing, err := client.WorkspaceSubscriptionGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			options := subscription.WorkspaceSubscriptionCreateOrUpdateOperationOptions{
+				AppType: pointer.To(subscription.AppTypeDeveloperPortal),
+				Notify:  pointer.To(false),
+			}
+			if _, err := client.WorkspaceSubscriptionCreateOrUpdate(ctx, id, expandApiManagementWorkspaceSubscription(model), options); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := subscription.ParseWorkspaceSubscriptions2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceSubscriptionGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementWorkspaceSubscriptionModel{
+				Name:                     id.SubscriptionName,
+				ApiManagementWorkspaceId: workspace.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.State = string(props.State)
+					state.AllowTracing = pointer.From(props.AllowTracing)
+
+					// the scope is either a product or an api within the workspace
+					if productId, err := product.ParseWorkspaceProductIDInsensitively(props.Scope); err == nil {
+						state.ProductId = productId.ID()
+					} else {
+						apiId, err := api.ParseWorkspaceApiIDInsensitively(props.Scope)
+						if err != nil {
+							return fmt.Errorf("parsing scope %q as a Workspace Product or API ID: %+v", props.Scope, err)
+						}
+						state.ApiId = apiId.ID()
+					}
+				}
+			}
+
+			// the keys are only returned from this additional API
+			keys, err := client.WorkspaceSubscriptionListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing the keys for %s: %+v", *id, err)
+			}
+			if model := keys.Model; model != nil {
+				state.PrimaryKey = pointer.From(model.PrimaryKey)
+				state.SecondaryKey = pointer.From(model.SecondaryKey)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := subscription.ParseWorkspaceSubscriptions2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := subscription.WorkspaceSubscriptionCreateOrUpdateOperationOptions{
+				AppType: pointer.To(subscription.AppTypeDeveloperPortal),
+				IfMatch: pointer.To("*"),
+				Notify:  pointer.To(false),
+			}
+			if _, err := client.WorkspaceSubscriptionCreateOrUpdate(ctx, *id, expandApiManagementWorkspaceSubscription(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceSubscriptionClient
+
+			id, err := subscription.ParseWorkspaceSubscriptions2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.WorkspaceSubscriptionDelete(ctx, *id, subscription.WorkspaceSubscriptionDeleteOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceSubscription(model ApiManagementWorkspaceSubscriptionModel) subscription.SubscriptionCreateParameters {
+	scope := model.ProductId
+	if model.ApiId != "" {
+		scope = model.ApiId
+	}
+
+	parameters := subscription.SubscriptionCreateParameters{
+		Properties: &subscription.SubscriptionCreateParameterProperties{
+			AllowTracing: pointer.To(model.AllowTracing),
+			DisplayName:  model.DisplayName,
+			Scope:        scope,
+			State:        pointer.To(subscription.SubscriptionState(model.State)),
+		},
+	}
+
+	if model.PrimaryKey != "" {
+		parameters.Properties.PrimaryKey = pointer.To(model.PrimaryKey)
+	}
+
+	if model.SecondaryKey != "" {
+		parameters.Properties.SecondaryKey = pointer.To(model.SecondaryKey)
+	}
+
+	return parameters
+}
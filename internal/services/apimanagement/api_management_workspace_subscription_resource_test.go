@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/subscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementWorkspaceSubscriptionResource struct{}
+
+func TestAccApiManagementWorkspaceSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("active"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceSubscription_api(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_subscription", "test")
+	r := ApiManagementWorkspaceSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.api(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceSubscriptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := subscription.ParseWorkspaceSubscriptions2ID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.WorkspaceSubscriptionClient.WorkspaceSubscriptionGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  name                        = "acctestsub-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Subscription"
+  product_id                  = azurerm_api_management_workspace_product.test.id
+}
+`, ApiManagementWorkspaceProductResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "import" {
+  name                        = azurerm_api_management_workspace_subscription.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_subscription.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_subscription.test.display_name
+  product_id                  = azurerm_api_management_workspace_subscription.test.product_id
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  name                        = "acctestsub-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Updated Test Subscription"
+  product_id                  = azurerm_api_management_workspace_product.test.id
+  state                       = "active"
+  allow_tracing               = false
+}
+`, ApiManagementWorkspaceProductResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceSubscriptionResource) api(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_subscription" "test" {
+  name                        = "acctestsub-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Test Subscription"
+  api_id                      = azurerm_api_management_workspace_api.test.id
+}
+`, ApiManagementWorkspaceApiResource{}.basic(data), data.RandomInteger)
+}
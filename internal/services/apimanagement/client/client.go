@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apidiagnostic"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationpolicy"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationtag"
@@ -53,6 +52,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tag"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tenantaccess"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/user"
+	api_v2023_05_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/apimanagementservice"
+	product_v2023_05_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/product"
+	subscription_v2023_05_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/subscription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2023-05-01-preview/workspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -104,6 +108,10 @@ type Client struct {
 	TagClient                          *tag.TagClient
 	TenantAccessClient                 *tenantaccess.TenantAccessClient
 	UsersClient                        *user.UserClient
+	WorkspaceApiClient                 *api_v2023_05_01_preview.ApiClient
+	WorkspaceClient                    *workspace.WorkspaceClient
+	WorkspaceProductClient             *product_v2023_05_01_preview.ProductClient
+	WorkspaceSubscriptionClient        *subscription_v2023_05_01_preview.SubscriptionClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -389,6 +397,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(usersClient.Client, o.Authorizers.ResourceManager)
 
+	workspaceClient, err := workspace.NewWorkspaceClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building workspace client: %+v", err)
+	}
+	o.Configure(workspaceClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceApiClient, err := api_v2023_05_01_preview.NewApiClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building workspace api client: %+v", err)
+	}
+	o.Configure(workspaceApiClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceProductClient, err := product_v2023_05_01_preview.NewProductClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building workspace product client: %+v", err)
+	}
+	o.Configure(workspaceProductClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceSubscriptionClient, err := subscription_v2023_05_01_preview.NewSubscriptionClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building workspace subscription client: %+v", err)
+	}
+	o.Configure(workspaceSubscriptionClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ApiClient:                          apiClient,
 		ApiDiagnosticClient:                apiDiagnosticClient,
@@ -437,5 +469,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		TagClient:                          tagClient,
 		TenantAccessClient:                 tenantAccessClient,
 		UsersClient:                        usersClient,
+		WorkspaceApiClient:                 workspaceApiClient,
+		WorkspaceClient:                    workspaceClient,
+		WorkspaceProductClient:             workspaceProductClient,
+		WorkspaceSubscriptionClient:        workspaceSubscriptionClient,
 	}, nil
 }
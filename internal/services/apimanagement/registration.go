@@ -101,5 +101,9 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ApiManagementNotificationRecipientEmailResource{},
 		ApiManagementNotificationRecipientUserResource{},
+		ApiManagementWorkspaceApiResource{},
+		ApiManagementWorkspaceProductResource{},
+		ApiManagementWorkspaceResource{},
+		ApiManagementWorkspaceSubscriptionResource{},
 	}
 }
@@ -76,6 +76,31 @@ func TestApimSkuName(t *testing.T) {
 			input: "PREMIUM_7",
 			valid: false,
 		},
+		{
+			name:  "BasicV2_0",
+			input: "BasicV2_0",
+			valid: false,
+		},
+		{
+			name:  "BasicV2_1",
+			input: "BasicV2_1",
+			valid: true,
+		},
+		{
+			name:  "StandardV2_10",
+			input: "StandardV2_10",
+			valid: true,
+		},
+		{
+			name:  "StandardV2_11",
+			input: "StandardV2_11",
+			valid: false,
+		},
+		{
+			name:  "PremiumV2_1",
+			input: "PremiumV2_1",
+			valid: false,
+		},
 	}
 	validationFunction := ApimSkuName()
 	for _, tt := range tests {
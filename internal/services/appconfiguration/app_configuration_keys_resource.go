@@ -0,0 +1,651 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+// KeysResource manages a named set of Key-Values as a single resource, which is refreshed by listing each label once
+// rather than retrieving each Key individually. The members of the set are tracked in state, and each Key-Value is
+// tagged with the name of the set so that the members can be found when the set is imported.
+type KeysResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = KeysResource{}
+	_ sdk.ResourceWithCustomizeDiff = KeysResource{}
+)
+
+const (
+	// keysResourceNullLabelFilter matches Key-Values without a label when listing, an empty label matches all labels
+	keysResourceNullLabelFilter = "\x00"
+
+	// keysResourceSetTagName is the tag used to record which set a Key-Value belongs to
+	keysResourceSetTagName = "azurerm_app_configuration_keys"
+
+	// keysResourceParallelism is the number of Key-Values which are written or removed concurrently, since the
+	// Data Plane API has no batch operations
+	keysResourceParallelism = 10
+)
+
+type KeysResourceModel struct {
+	Name                 string                      `tfschema:"name"`
+	ConfigurationStoreId string                      `tfschema:"configuration_store_id"`
+	KeyValues            []KeysResourceKeyValueModel `tfschema:"key_value"`
+}
+
+type KeysResourceKeyValueModel struct {
+	Key         string `tfschema:"key"`
+	Label       string `tfschema:"label"`
+	Value       string `tfschema:"value"`
+	ContentType string `tfschema:"content_type"`
+}
+
+func (k KeysResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`),
+				"`name` must be between 1 and 128 characters and can only contain alphanumeric characters, periods, underscores and hyphens",
+			),
+		},
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+		"key_value": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validateAppConfigurationKeysKey,
+					},
+					"label": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+					"value": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+					"content_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (k KeysResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (k KeysResource) ModelObject() interface{} {
+	return &KeysResourceModel{}
+}
+
+func (k KeysResource) ResourceType() string {
+	return "azurerm_app_configuration_keys"
+}
+
+func (k KeysResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.KeyValueSetId
+}
+
+func (k KeysResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			seen := make(map[parse.KeyValueSetItem]struct{})
+			for _, raw := range metadata.ResourceDiff.Get("key_value").(*pluginsdk.Set).List() {
+				v := raw.(map[string]interface{})
+				item := parse.KeyValueSetItem{
+					Key:   v["key"].(string),
+					Label: v["label"].(string),
+				}
+				// the key may not be known until apply
+				if item.Key == "" {
+					continue
+				}
+
+				if _, ok := seen[item]; ok {
+					return fmt.Errorf("the Key %q with the Label %q is specified more than once in `key_value`", item.Key, item.Label)
+				}
+				seen[item] = struct{}{}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (k KeysResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 45 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeysResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for %s: %s", *configurationStoreId, err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			listClient, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			id, err := parse.NewKeyValueSetID(client.Endpoint, model.Name)
+			if err != nil {
+				return err
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			first := model.KeyValues[0]
+			last := model.KeyValues[len(model.KeyValues)-1]
+
+			// from https://learn.microsoft.com/en-us/azure/azure-app-configuration/concept-enable-rbac#azure-built-in-roles-for-azure-app-configuration
+			// allow some time for role permission to be propagated
+			metadata.Logger.Infof("[DEBUG] Waiting for %s read permission to be propagated", *id)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{"Forbidden"},
+				Target:                    []string{"Error", "Exists", "NotFound"},
+				Refresh:                   appConfigurationGetKeyRefreshFunc(ctx, client, first.Key, first.Label),
+				PollInterval:              10 * time.Second,
+				ContinuousTargetOccurence: 3,
+				Timeout:                   time.Until(deadline),
+			}
+
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s read permission to be propagated: %+v", *id, err)
+			}
+
+			existing, err := listAppConfigurationKeyValuesForItems(ctx, listClient, keyValueSetItems(model.KeyValues))
+			if err != nil {
+				return fmt.Errorf("listing existing Keys for %s: %+v", *id, err)
+			}
+			if len(existing) > 0 {
+				return tf.ImportAsExistsError(k.ResourceType(), id.ID())
+			}
+
+			// the ID is set before any Key-Values are written so that those which were written are removed if this fails part way through
+			metadata.SetID(id)
+
+			if err := putAppConfigurationKeyValues(ctx, client, id.Name, model.KeyValues); err != nil {
+				return fmt.Errorf("creating %s: %+v", *id, err)
+			}
+
+			// https://github.com/Azure/AppConfiguration/issues/763
+			metadata.Logger.Infof("[DEBUG] Waiting for %s to be provisioned", *id)
+			stateConf = &pluginsdk.StateChangeConf{
+				Pending:                   []string{"NotFound", "Forbidden"},
+				Target:                    []string{"Exists"},
+				Refresh:                   appConfigurationGetKeyRefreshFunc(ctx, client, last.Key, last.Label),
+				PollInterval:              5 * time.Second,
+				ContinuousTargetOccurence: 4,
+				Timeout:                   time.Until(deadline),
+			}
+
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to be provisioned: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (k KeysResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeyValueSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			domainSuffix, ok := metadata.Client.Account.Environment.AppConfiguration.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine AppConfiguration domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+			configurationStoreIdRaw, err := metadata.Client.AppConfiguration.ConfigurationStoreIDFromEndpoint(ctx, subscriptionId, id.ConfigurationStoreEndpoint, *domainSuffix)
+			if err != nil {
+				return fmt.Errorf("while retrieving the Resource ID of Configuration Store at Endpoint: %q: %s", id.ConfigurationStoreEndpoint, err)
+			}
+			if configurationStoreIdRaw == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(*configurationStoreIdRaw)
+			if err != nil {
+				return err
+			}
+
+			exists, err := metadata.Client.AppConfiguration.Exists(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("while checking %s for %s existence: %v", *configurationStoreId, *id, err)
+			}
+			if !exists {
+				log.Printf("[DEBUG] %s for %s was not found - removing from state", *configurationStoreId, *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			var state KeysResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			var existing map[parse.KeyValueSetItem]azuresdkhacks.KeyValue
+			items := keyValueSetItems(state.KeyValues)
+			if len(items) == 0 {
+				// the members of the set aren't known when it's being imported, so they're found using the tag on each Key-Value
+				existing, err = listAppConfigurationKeyValuesForSet(ctx, client, id.Name)
+				if err != nil {
+					return fmt.Errorf("listing Keys for %s: %+v", *id, err)
+				}
+				for item := range existing {
+					items = append(items, item)
+				}
+			} else {
+				existing, err = listAppConfigurationKeyValuesForItems(ctx, client, items)
+				if err != nil {
+					return fmt.Errorf("listing Keys for %s: %+v", *id, err)
+				}
+			}
+
+			model := KeysResourceModel{
+				Name:                 id.Name,
+				ConfigurationStoreId: configurationStoreId.ID(),
+				KeyValues:            make([]KeysResourceKeyValueModel, 0),
+			}
+
+			// Keys which have been removed outside of Terraform are omitted, so that they're recreated
+			for _, item := range items {
+				kv, ok := existing[item]
+				if !ok {
+					log.Printf("[DEBUG] the Key %q with the Label %q in %s was not found", item.Key, item.Label, *id)
+					continue
+				}
+
+				contentType := utils.NormalizeNilableString(kv.ContentType)
+				if contentType == VaultKeyContentType {
+					return fmt.Errorf("the Key %q with the Label %q in %s is a Key Vault reference, which must be managed using `azurerm_app_configuration_key`", item.Key, item.Label, *id)
+				}
+
+				model.KeyValues = append(model.KeyValues, KeysResourceKeyValueModel{
+					Key:         item.Key,
+					Label:       item.Label,
+					Value:       utils.NormalizeNilableString(kv.Value),
+					ContentType: contentType,
+				})
+			}
+
+			if len(model.KeyValues) == 0 {
+				log.Printf("[DEBUG] no Keys were found for %s - removing from state", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (k KeysResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeyValueSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			var model KeysResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			metadata.Client.AppConfiguration.AddToCache(*configurationStoreId, id.ConfigurationStoreEndpoint)
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			listClient, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			oldRaw, _ := metadata.ResourceData.GetChange("key_value")
+			oldKeyValues := make(map[parse.KeyValueSetItem]KeysResourceKeyValueModel)
+			for _, raw := range oldRaw.(*pluginsdk.Set).List() {
+				v := raw.(map[string]interface{})
+				kv := KeysResourceKeyValueModel{
+					Key:         v["key"].(string),
+					Label:       v["label"].(string),
+					Value:       v["value"].(string),
+					ContentType: v["content_type"].(string),
+				}
+				oldKeyValues[parse.KeyValueSetItem{Key: kv.Key, Label: kv.Label}] = kv
+			}
+
+			newItems := make(map[parse.KeyValueSetItem]struct{})
+			for _, item := range keyValueSetItems(model.KeyValues) {
+				newItems[item] = struct{}{}
+			}
+
+			// Keys which are added to the set mustn't already exist, since they'd otherwise be managed by two resources
+			added := make([]parse.KeyValueSetItem, 0)
+			for item := range newItems {
+				if _, ok := oldKeyValues[item]; !ok {
+					added = append(added, item)
+				}
+			}
+			if len(added) > 0 {
+				existing, err := listAppConfigurationKeyValuesForItems(ctx, listClient, added)
+				if err != nil {
+					return fmt.Errorf("listing existing Keys for %s: %+v", *id, err)
+				}
+				for _, item := range added {
+					if _, ok := existing[item]; !ok {
+						continue
+					}
+					return fmt.Errorf("the Key %q with the Label %q already exists in the Configuration Store at %q and must be removed before it can be managed by this resource", item.Key, item.Label, id.ConfigurationStoreEndpoint)
+				}
+			}
+
+			changed := make([]KeysResourceKeyValueModel, 0)
+			for _, kv := range model.KeyValues {
+				if oldKv, ok := oldKeyValues[parse.KeyValueSetItem{Key: kv.Key, Label: kv.Label}]; ok && oldKv == kv {
+					continue
+				}
+				changed = append(changed, kv)
+			}
+			if err := putAppConfigurationKeyValues(ctx, client, id.Name, changed); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			removed := make([]KeysResourceKeyValueModel, 0)
+			for item, kv := range oldKeyValues {
+				if _, ok := newItems[item]; !ok {
+					removed = append(removed, kv)
+				}
+			}
+			if err := deleteAppConfigurationKeyValues(ctx, client, removed); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (k KeysResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeyValueSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			var model KeysResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			if err := deleteAppConfigurationKeyValues(ctx, client, model.KeyValues); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// putAppConfigurationKeyValues writes the specified Key-Values concurrently, tagging each with the name of the set
+func putAppConfigurationKeyValues(ctx context.Context, client *appconfiguration.BaseClient, setName string, input []KeysResourceKeyValueModel) error {
+	return forEachAppConfigurationKeyValue(input, func(kv KeysResourceKeyValueModel) error {
+		entity := appconfiguration.KeyValue{
+			Key:         utils.String(kv.Key),
+			Label:       utils.String(kv.Label),
+			Value:       utils.String(kv.Value),
+			ContentType: utils.String(kv.ContentType),
+			Tags: map[string]*string{
+				keysResourceSetTagName: utils.String(setName),
+			},
+		}
+
+		if _, err := client.PutKeyValue(ctx, kv.Key, kv.Label, &entity, "", ""); err != nil {
+			return fmt.Errorf("while setting key/label pair %s/%s: %+v", kv.Key, kv.Label, err)
+		}
+
+		return nil
+	})
+}
+
+// deleteAppConfigurationKeyValues removes the specified Key-Values concurrently, ignoring those which are already gone
+func deleteAppConfigurationKeyValues(ctx context.Context, client *appconfiguration.BaseClient, input []KeysResourceKeyValueModel) error {
+	return forEachAppConfigurationKeyValue(input, func(kv KeysResourceKeyValueModel) error {
+		if _, err := client.DeleteKeyValue(ctx, kv.Key, kv.Label, ""); err != nil {
+			if v, ok := err.(autorest.DetailedError); ok && utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+				return nil
+			}
+			return fmt.Errorf("while removing key/label pair %s/%s: %+v", kv.Key, kv.Label, err)
+		}
+
+		return nil
+	})
+}
+
+// forEachAppConfigurationKeyValue calls the specified function for each Key-Value using a fixed number of workers,
+// returning the first error once all of the Key-Values have been processed
+func forEachAppConfigurationKeyValue(input []KeysResourceKeyValueModel, f func(kv KeysResourceKeyValueModel) error) error {
+	keyValues := make(chan KeysResourceKeyValueModel, len(input))
+	for _, kv := range input {
+		keyValues <- kv
+	}
+	close(keyValues)
+
+	errors := make(chan error, len(input))
+	wg := &sync.WaitGroup{}
+	for i := 0; i < keysResourceParallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for kv := range keyValues {
+				if err := f(kv); err != nil {
+					errors <- err
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errors)
+
+	if len(errors) > 0 {
+		return fmt.Errorf("%d of %d Key-Values failed, the first error was: %s", len(errors), len(input), <-errors)
+	}
+
+	return nil
+}
+
+// listAppConfigurationKeyValuesForItems returns the Key-Values matching the specified items which exist, listing each label once
+func listAppConfigurationKeyValuesForItems(ctx context.Context, client *azuresdkhacks.DataPlaneClient, items []parse.KeyValueSetItem) (map[parse.KeyValueSetItem]azuresdkhacks.KeyValue, error) {
+	keysByLabel := make(map[string]map[string]struct{})
+	for _, item := range items {
+		if _, ok := keysByLabel[item.Label]; !ok {
+			keysByLabel[item.Label] = make(map[string]struct{})
+		}
+		keysByLabel[item.Label][item.Key] = struct{}{}
+	}
+
+	results := make(map[parse.KeyValueSetItem]azuresdkhacks.KeyValue)
+	for label, keys := range keysByLabel {
+		existing, err := listAppConfigurationKeyValues(ctx, client, label)
+		if err != nil {
+			return nil, fmt.Errorf("listing Keys with the Label %q: %+v", label, err)
+		}
+
+		for key := range keys {
+			if kv, ok := existing[key]; ok {
+				results[parse.KeyValueSetItem{Key: key, Label: label}] = kv
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// listAppConfigurationKeyValuesForSet returns the Key-Values with any label which are tagged as members of the specified set
+func listAppConfigurationKeyValuesForSet(ctx context.Context, client *azuresdkhacks.DataPlaneClient, setName string) (map[parse.KeyValueSetItem]azuresdkhacks.KeyValue, error) {
+	results := make(map[parse.KeyValueSetItem]azuresdkhacks.KeyValue)
+
+	iter, err := client.GetKeyValuesComplete(ctx, "", "", "", "", []appconfiguration.KeyValueFields{})
+	if err != nil {
+		return nil, err
+	}
+
+	for iter.NotDone() {
+		kv := iter.Value()
+		if kv.Key != nil && kv.Tags != nil && utils.NormalizeNilableString(kv.Tags[keysResourceSetTagName]) == setName {
+			item := parse.KeyValueSetItem{
+				Key:   *kv.Key,
+				Label: utils.NormalizeNilableString(kv.Label),
+			}
+			results[item] = kv
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// listAppConfigurationKeyValues returns all of the Key-Values with the specified label, keyed by their Key
+func listAppConfigurationKeyValues(ctx context.Context, client *azuresdkhacks.DataPlaneClient, label string) (map[string]azuresdkhacks.KeyValue, error) {
+	labelFilter := label
+	if labelFilter == "" {
+		labelFilter = keysResourceNullLabelFilter
+	}
+
+	results := make(map[string]azuresdkhacks.KeyValue)
+
+	iter, err := client.GetKeyValuesComplete(ctx, "", labelFilter, "", "", []appconfiguration.KeyValueFields{})
+	if err != nil {
+		return nil, err
+	}
+
+	for iter.NotDone() {
+		kv := iter.Value()
+		if kv.Key != nil {
+			results[*kv.Key] = kv
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func validateAppConfigurationKeysKey(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if v == "." || v == ".." || strings.Contains(v, "%") {
+		errors = append(errors, fmt.Errorf("%q cannot be `.` or `..` or contain `%%`, got %q", k, v))
+	}
+
+	return
+}
+
+func keyValueSetItems(input []KeysResourceKeyValueModel) []parse.KeyValueSetItem {
+	items := make([]parse.KeyValueSetItem, 0, len(input))
+	for _, kv := range input {
+		items = append(items, parse.KeyValueSetItem{
+			Key:   kv.Key,
+			Label: kv.Label,
+		})
+	}
+	return items
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+type AppConfigurationKeysResource struct{}
+
+func TestAccAppConfigurationKeys_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_value.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeys_noLabel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.noLabel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeys_multipleLabels(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleLabels(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_value.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeys_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_value.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeys_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys", "test")
+	r := AppConfigurationKeysResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t AppConfigurationKeysResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseKeyValueSetID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	client, err := clients.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := client.GetKeyValuesComplete(ctx, "", "", "", "", []appconfiguration.KeyValueFields{})
+	if err != nil {
+		return nil, fmt.Errorf("while listing keys for %s: %+v", *id, err)
+	}
+
+	count := 0
+	for iter.NotDone() {
+		if tags := iter.Value().Tags; tags != nil && utils.NormalizeNilableString(tags["azurerm_app_configuration_keys"]) == id.Name {
+			count++
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("while listing keys for %s: %+v", *id, err)
+		}
+	}
+
+	return utils.Bool(strconv.Itoa(count) == state.Attributes["key_value.#"]), nil
+}
+
+func (t AppConfigurationKeysResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "test" {
+  name                   = "acctest-keys"
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  key_value {
+    key          = "first"
+    label        = "acctest-ackeyslabel-%[2]d"
+    value        = "a test"
+    content_type = "test"
+  }
+
+  key_value {
+    key          = "second"
+    label        = "acctest-ackeyslabel-%[2]d"
+    value        = "another test"
+    content_type = "test"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeysResource) noLabel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "test" {
+  name                   = "acctest-keys"
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  key_value {
+    key   = "app/first"
+    value = "a test"
+  }
+
+  key_value {
+    key   = "app/second"
+    value = "another test"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data))
+}
+
+func (t AppConfigurationKeysResource) multipleLabels(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "test" {
+  name                   = "acctest-keys"
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  key_value {
+    key   = "app/timeout"
+    value = "30"
+  }
+
+  key_value {
+    key          = "app/timeout"
+    label        = "acctest-ackeyslabel-%[2]d"
+    value        = "60"
+    content_type = "text/plain"
+  }
+
+  key_value {
+    key          = "app/settings"
+    label        = "acctest-ackeyslabel-%[2]d"
+    value        = jsonencode({ colour = "blue" })
+    content_type = "application/json"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeysResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "test" {
+  name                   = "acctest-keys"
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  key_value {
+    key          = "second"
+    label        = "acctest-ackeyslabel-%[2]d"
+    value        = "updated"
+    content_type = "application/json"
+  }
+
+  key_value {
+    key          = "third"
+    label        = "acctest-ackeyslabel-%[2]d"
+    value        = "new"
+    content_type = "test"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeysResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys" "import" {
+  name                   = azurerm_app_configuration_keys.test.name
+  configuration_store_id = azurerm_app_configuration_keys.test.configuration_store_id
+
+  dynamic "key_value" {
+    for_each = azurerm_app_configuration_keys.test.key_value
+    content {
+      key          = key_value.value.key
+      label        = key_value.value.label
+      value        = key_value.value.value
+      content_type = key_value.value.content_type
+    }
+  }
+}
+`, t.basic(data))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = KeyValueSetId{}
+
+// KeyValueSetId identifies a named set of App Configuration Key-Values, the members of which are tracked in state
+type KeyValueSetId struct {
+	ConfigurationStoreEndpoint string
+	Name                       string
+}
+
+type KeyValueSetItem struct {
+	Key   string
+	Label string
+}
+
+func NewKeyValueSetID(configurationStoreEndpoint string, name string) (*KeyValueSetId, error) {
+	// configurationStoreEndpoint example: https://testappconf1.azconfig.io
+	configurationURL, err := url.ParseRequestURI(configurationStoreEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", configurationStoreEndpoint, err)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("the name of the Key-Value Set must not be empty")
+	}
+
+	return &KeyValueSetId{
+		ConfigurationStoreEndpoint: fmt.Sprintf("%s://%s", configurationURL.Scheme, configurationURL.Host),
+		Name:                       name,
+	}, nil
+}
+
+func (id KeyValueSetId) ID() string {
+	// example: https://testappconf1.azconfig.io/kvset/set1
+	return fmt.Sprintf("%s/kvset/%s", id.ConfigurationStoreEndpoint, id.Name)
+}
+
+func (id KeyValueSetId) String() string {
+	components := []string{
+		fmt.Sprintf("Configuration Store Endpoint %q", id.ConfigurationStoreEndpoint),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	return fmt.Sprintf("AppConfiguration Key-Value Set %s", strings.Join(components, " / "))
+}
+
+// ParseKeyValueSetID parses an App Configuration Key-Value Set ID
+func ParseKeyValueSetID(input string) (*KeyValueSetId, error) {
+	// example: https://testappconf1.azconfig.io/kvset/set1
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse Azure App Configuration Key-Value Set ID %q: %s", input, err)
+	}
+
+	if idURL.RawQuery != "" {
+		return nil, fmt.Errorf("AppConfiguration Key-Value Set ID should not contain a query, got %q", idURL.RawQuery)
+	}
+
+	name, ok := strings.CutPrefix(idURL.Path, "/kvset/")
+	if !ok || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("AppConfiguration Key-Value Set should have the path `kvset/{name}`, got %q", idURL.Path)
+	}
+
+	return NewKeyValueSetID(fmt.Sprintf("%s://%s", idURL.Scheme, idURL.Host), name)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestNewKeyValueSetID(t *testing.T) {
+	cases := []struct {
+		ConfigurationStoreEndpoint string
+		Name                       string
+		Expected                   string
+		ExpectError                bool
+	}{
+		{
+			ConfigurationStoreEndpoint: "",
+			Name:                       "set1",
+			ExpectError:                true,
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			Name:                       "",
+			ExpectError:                true,
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			Name:                       "set1",
+			Expected:                   "https://testappconf1.azconfig.io/kvset/set1",
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io/",
+			Name:                       "app.settings-1",
+			Expected:                   "https://testappconf1.azconfig.io/kvset/app.settings-1",
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := NewKeyValueSetID(tc.ConfigurationStoreEndpoint, tc.Name)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for New Key-Value Set ID (BaseURL:%q, Name:%q): %+v", tc.ConfigurationStoreEndpoint, tc.Name, err)
+			}
+			continue
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for New Key-Value Set ID (BaseURL:%q, Name:%q)", tc.ConfigurationStoreEndpoint, tc.Name)
+		}
+		if id.ID() != tc.Expected {
+			t.Fatalf("Expected id for (BaseURL:%q, Name:%q) to be %q, got %q", tc.ConfigurationStoreEndpoint, tc.Name, tc.Expected, id.ID())
+		}
+	}
+}
+
+func TestParseKeyValueSetID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    KeyValueSetId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kvset",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kvset/",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv/testKey?label=testLabel",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kvset?key=testKey&label=testLabel",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kvset/set1/nested",
+			ExpectError: true,
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/kvset/set1",
+			Expected: KeyValueSetId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				Name:                       "set1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := ParseKeyValueSetID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID %q", tc.Input)
+		}
+
+		if tc.Expected.ConfigurationStoreEndpoint != id.ConfigurationStoreEndpoint {
+			t.Fatalf("Expected ConfigurationStoreEndpoint to be %q, got %q for ID %q", tc.Expected.ConfigurationStoreEndpoint, id.ConfigurationStoreEndpoint, tc.Input)
+		}
+
+		if tc.Expected.Name != id.Name {
+			t.Fatalf("Expected Name to be %q, got %q for ID %q", tc.Expected.Name, id.Name, tc.Input)
+		}
+	}
+}
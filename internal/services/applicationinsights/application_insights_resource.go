@@ -4,6 +4,7 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -39,6 +40,22 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// migrating a classic component to a workspace-based one is one-way, so catch attempts to revert it at plan time
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if d.Id() == "" || !d.HasChange("workspace_id") || !d.NewValueKnown("workspace_id") {
+					return nil
+				}
+
+				oldWorkspaceId, newWorkspaceId := d.GetChange("workspace_id")
+				if oldWorkspaceId.(string) != "" && newWorkspaceId.(string) == "" {
+					return fmt.Errorf("`workspace_id` cannot be removed once set, a workspace-based Application Insights component cannot be converted back to a classic component")
+				}
+
+				return nil
+			}),
+		),
+
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.ComponentUpgradeV0ToV1{},
@@ -229,19 +246,13 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 		ForceCustomerStorageForProfiler: pointer.To(d.Get("force_customer_storage_for_profiler").(bool)),
 	}
 
-	if !d.IsNewResource() {
-		oldWorkspaceId, newWorkspaceId := d.GetChange("workspace_id")
-		if oldWorkspaceId.(string) != "" && newWorkspaceId.(string) == "" {
-			return fmt.Errorf("`workspace_id` can not be removed after set")
-		}
-	}
-
 	if workspaceRaw, hasWorkspaceId := d.GetOk("workspace_id"); hasWorkspaceId {
 		workspaceID, err := workspaces.ParseWorkspaceID(workspaceRaw.(string))
 		if err != nil {
 			return err
 		}
 		applicationInsightsComponentProperties.WorkspaceResourceId = pointer.To(workspaceID.ID())
+		applicationInsightsComponentProperties.IngestionMode = pointer.To(components.IngestionModeLogAnalytics)
 	}
 
 	if v, ok := d.GetOk("retention_in_days"); ok {
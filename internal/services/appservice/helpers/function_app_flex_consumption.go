@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type FunctionAppScaleAndConcurrency struct {
+	InstanceMemoryInMB   int64                    `tfschema:"instance_memory_in_mb"`
+	MaximumInstanceCount int64                    `tfschema:"maximum_instance_count"`
+	HttpConcurrency      int64                    `tfschema:"http_concurrency"`
+	AlwaysReadyInstances []FunctionAppAlwaysReady `tfschema:"always_ready"`
+}
+
+type FunctionAppAlwaysReady struct {
+	Name          string `tfschema:"name"`
+	InstanceCount int64  `tfschema:"instance_count"`
+}
+
+type FunctionAppDeploymentStorage struct {
+	ContainerEndpoint      string `tfschema:"container_endpoint"`
+	AuthenticationType     string `tfschema:"authentication_type"`
+	ConnectionStringName   string `tfschema:"connection_string_name"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+}
+
+func FunctionAppScaleAndConcurrencySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"instance_memory_in_mb": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      2048,
+					ValidateFunc: validation.IntInSlice([]int{512, 2048, 4096}),
+					Description:  "The amount of memory in MB allocated to each instance of the Function App. Possible values are `512`, `2048` and `4096`.",
+				},
+
+				"maximum_instance_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      100,
+					ValidateFunc: validation.IntBetween(40, 1000),
+					Description:  "The maximum number of instances the Function App can scale out to.",
+				},
+
+				"http_concurrency": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(1, 1000),
+					Description:  "The maximum number of concurrent HTTP trigger invocations per instance.",
+				},
+
+				"always_ready": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the trigger group or function to keep instances ready for. e.g. `http`, `blob`, `durable` or `function:<function_name>`.",
+							},
+
+							"instance_count": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The number of instances which are always ready for this trigger group or function.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func FunctionAppDeploymentStorageSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The URL of the Storage Blob Container used to hold the deployment package of the Function App.",
+				},
+
+				"authentication_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(webapps.PossibleValuesForAuthenticationType(), false),
+					Description:  "The type of authentication used to access the deployment storage container.",
+				},
+
+				"connection_string_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the App Setting containing the connection string of the Storage Account. Required when `authentication_type` is `StorageAccountConnectionString`.",
+				},
+
+				"user_assigned_identity_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					Description:  "The ID of the User Assigned Identity used to access the Storage Account. Required when `authentication_type` is `UserAssignedIdentity`.",
+				},
+			},
+		},
+	}
+}
+
+// ExpandFunctionAppConfig builds the Flex Consumption configuration for a Function App. The runtime is taken from the
+// `application_stack` since Flex Consumption apps do not use `linuxFxVersion` or the `FUNCTIONS_WORKER_RUNTIME` App Setting.
+func ExpandFunctionAppConfig(scale []FunctionAppScaleAndConcurrency, storage []FunctionAppDeploymentStorage, appStack []ApplicationStackLinuxFunctionApp) (*webapps.FunctionAppConfig, error) {
+	if len(storage) == 0 {
+		return nil, fmt.Errorf("`deployment_storage` must be specified for Function Apps on a Flex Consumption Service Plan")
+	}
+
+	runtime, err := expandFunctionAppFlexRuntime(appStack)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentStorage := storage[0]
+	authentication := &webapps.FunctionsDeploymentStorageAuthentication{
+		Type: pointer.To(webapps.AuthenticationType(deploymentStorage.AuthenticationType)),
+	}
+
+	switch webapps.AuthenticationType(deploymentStorage.AuthenticationType) {
+	case webapps.AuthenticationTypeStorageAccountConnectionString:
+		if deploymentStorage.ConnectionStringName == "" {
+			return nil, fmt.Errorf("`connection_string_name` must be specified when `authentication_type` is `%s`", webapps.AuthenticationTypeStorageAccountConnectionString)
+		}
+		authentication.StorageAccountConnectionStringName = pointer.To(deploymentStorage.ConnectionStringName)
+	case webapps.AuthenticationTypeUserAssignedIdentity:
+		if deploymentStorage.UserAssignedIdentityId == "" {
+			return nil, fmt.Errorf("`user_assigned_identity_id` must be specified when `authentication_type` is `%s`", webapps.AuthenticationTypeUserAssignedIdentity)
+		}
+		authentication.UserAssignedIdentityResourceId = pointer.To(deploymentStorage.UserAssignedIdentityId)
+	}
+
+	result := &webapps.FunctionAppConfig{
+		Deployment: &webapps.FunctionsDeployment{
+			Storage: &webapps.FunctionsDeploymentStorage{
+				Type:           pointer.To(webapps.FunctionsDeploymentStorageTypeBlobContainer),
+				Value:          pointer.To(deploymentStorage.ContainerEndpoint),
+				Authentication: authentication,
+			},
+		},
+		Runtime: runtime,
+		ScaleAndConcurrency: &webapps.FunctionsScaleAndConcurrency{
+			InstanceMemoryMB:     pointer.To(float64(2048)),
+			MaximumInstanceCount: pointer.To(float64(100)),
+		},
+	}
+
+	if len(scale) == 1 {
+		s := scale[0]
+		result.ScaleAndConcurrency.InstanceMemoryMB = pointer.To(float64(s.InstanceMemoryInMB))
+		result.ScaleAndConcurrency.MaximumInstanceCount = pointer.To(float64(s.MaximumInstanceCount))
+
+		if s.HttpConcurrency != 0 {
+			result.ScaleAndConcurrency.Triggers = &webapps.FunctionsScaleAndConcurrencyTriggers{
+				HTTP: &webapps.FunctionsScaleAndConcurrencyTriggersHTTP{
+					PerInstanceConcurrency: pointer.To(float64(s.HttpConcurrency)),
+				},
+			}
+		}
+
+		alwaysReady := make([]webapps.FunctionsAlwaysReadyConfig, 0)
+		for _, v := range s.AlwaysReadyInstances {
+			alwaysReady = append(alwaysReady, webapps.FunctionsAlwaysReadyConfig{
+				Name:          pointer.To(v.Name),
+				InstanceCount: pointer.To(float64(v.InstanceCount)),
+			})
+		}
+		result.ScaleAndConcurrency.AlwaysReady = &alwaysReady
+	}
+
+	return result, nil
+}
+
+func FlattenFunctionAppConfig(input *webapps.FunctionAppConfig) ([]FunctionAppScaleAndConcurrency, []FunctionAppDeploymentStorage) {
+	scale := make([]FunctionAppScaleAndConcurrency, 0)
+	storage := make([]FunctionAppDeploymentStorage, 0)
+	if input == nil {
+		return scale, storage
+	}
+
+	if s := input.ScaleAndConcurrency; s != nil {
+		flattened := FunctionAppScaleAndConcurrency{
+			InstanceMemoryInMB:   int64(pointer.From(s.InstanceMemoryMB)),
+			MaximumInstanceCount: int64(pointer.From(s.MaximumInstanceCount)),
+		}
+
+		if triggers := s.Triggers; triggers != nil && triggers.HTTP != nil {
+			flattened.HttpConcurrency = int64(pointer.From(triggers.HTTP.PerInstanceConcurrency))
+		}
+
+		if alwaysReady := s.AlwaysReady; alwaysReady != nil {
+			for _, v := range *alwaysReady {
+				flattened.AlwaysReadyInstances = append(flattened.AlwaysReadyInstances, FunctionAppAlwaysReady{
+					Name:          pointer.From(v.Name),
+					InstanceCount: int64(pointer.From(v.InstanceCount)),
+				})
+			}
+		}
+
+		scale = append(scale, flattened)
+	}
+
+	if d := input.Deployment; d != nil && d.Storage != nil {
+		flattened := FunctionAppDeploymentStorage{
+			ContainerEndpoint: pointer.From(d.Storage.Value),
+		}
+
+		if auth := d.Storage.Authentication; auth != nil {
+			flattened.AuthenticationType = string(pointer.From(auth.Type))
+			flattened.ConnectionStringName = pointer.From(auth.StorageAccountConnectionStringName)
+			if auth.UserAssignedIdentityResourceId != nil {
+				if identityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(*auth.UserAssignedIdentityResourceId); err == nil {
+					flattened.UserAssignedIdentityId = identityId.ID()
+				}
+			}
+		}
+
+		storage = append(storage, flattened)
+	}
+
+	return scale, storage
+}
+
+// FilterFlexConsumptionAppSettings removes the App Settings which are configured through the `functionAppConfig` for
+// Flex Consumption apps, and which the service rejects if they are sent.
+func FilterFlexConsumptionAppSettings(input *[]webapps.NameValuePair) *[]webapps.NameValuePair {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]webapps.NameValuePair, 0)
+	for _, v := range *input {
+		switch strings.ToUpper(pointer.From(v.Name)) {
+		case "FUNCTIONS_WORKER_RUNTIME", "FUNCTIONS_EXTENSION_VERSION", "WEBSITE_CONTENTSHARE", "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING":
+			continue
+		}
+		result = append(result, v)
+	}
+
+	return &result
+}
+
+func expandFunctionAppFlexRuntime(input []ApplicationStackLinuxFunctionApp) (*webapps.FunctionsRuntime, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("an `application_stack` must be specified for Function Apps on a Flex Consumption Service Plan")
+	}
+
+	appStack := input[0]
+	switch {
+	case appStack.DotNetVersion != "":
+		if !appStack.DotNetIsolated {
+			return nil, fmt.Errorf("`use_dotnet_isolated_runtime` must be `true` for Function Apps on a Flex Consumption Service Plan")
+		}
+		return &webapps.FunctionsRuntime{
+			Name:    pointer.To(webapps.RuntimeNameDotnetNegativeisolated),
+			Version: pointer.To(appStack.DotNetVersion),
+		}, nil
+
+	case appStack.NodeVersion != "":
+		return &webapps.FunctionsRuntime{
+			Name:    pointer.To(webapps.RuntimeNameNode),
+			Version: pointer.To(appStack.NodeVersion),
+		}, nil
+
+	case appStack.PythonVersion != "":
+		return &webapps.FunctionsRuntime{
+			Name:    pointer.To(webapps.RuntimeNamePython),
+			Version: pointer.To(appStack.PythonVersion),
+		}, nil
+
+	case appStack.JavaVersion != "":
+		return &webapps.FunctionsRuntime{
+			Name:    pointer.To(webapps.RuntimeNameJava),
+			Version: pointer.To(appStack.JavaVersion),
+		}, nil
+
+	case appStack.PowerShellCoreVersion != "":
+		return &webapps.FunctionsRuntime{
+			Name:    pointer.To(webapps.RuntimeNamePowershell),
+			Version: pointer.To(appStack.PowerShellCoreVersion),
+		}, nil
+
+	case appStack.CustomHandler:
+		return &webapps.FunctionsRuntime{
+			Name:    pointer.To(webapps.RuntimeNameCustom),
+			Version: pointer.To("1.0"),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("the `application_stack` is not supported for Function Apps on a Flex Consumption Service Plan")
+}
+
+// FlattenFunctionAppFlexRuntime returns the `application_stack` for a Flex Consumption app from the runtime in its `functionAppConfig`
+func FlattenFunctionAppFlexRuntime(input *webapps.FunctionAppConfig) []ApplicationStackLinuxFunctionApp {
+	if input == nil || input.Runtime == nil || input.Runtime.Name == nil {
+		return nil
+	}
+
+	version := pointer.From(input.Runtime.Version)
+	appStack := ApplicationStackLinuxFunctionApp{}
+	switch *input.Runtime.Name {
+	case webapps.RuntimeNameDotnetNegativeisolated:
+		appStack.DotNetVersion = version
+		appStack.DotNetIsolated = true
+	case webapps.RuntimeNameNode:
+		appStack.NodeVersion = version
+	case webapps.RuntimeNamePython:
+		appStack.PythonVersion = version
+	case webapps.RuntimeNameJava:
+		appStack.JavaVersion = version
+	case webapps.RuntimeNamePowershell:
+		appStack.PowerShellCoreVersion = version
+	case webapps.RuntimeNameCustom:
+		appStack.CustomHandler = true
+	}
+
+	return []ApplicationStackLinuxFunctionApp{appStack}
+}
@@ -9,7 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
@@ -53,6 +53,7 @@ type SiteConfigLinux struct {
 	DetailedErrorLogging          bool                    `tfschema:"detailed_error_logging_enabled"`
 	LinuxFxVersion                string                  `tfschema:"linux_fx_version"`
 	VnetRouteAllEnabled           bool                    `tfschema:"vnet_route_all_enabled"`
+	Sidecars                      []SidecarContainer      `tfschema:"sidecar"`
 	// SiteLimits []SiteLimitsSettings `tfschema:"site_limits"` // TODO - New block to (possibly) support? No way to configure this in the portal?
 }
 
@@ -88,6 +89,8 @@ func SiteConfigSchemaLinux() *pluginsdk.Schema {
 
 				"application_stack": linuxApplicationStackSchema(),
 
+				"sidecar": SidecarSchema(),
+
 				"auto_heal_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
@@ -321,6 +324,8 @@ func SiteConfigSchemaLinuxComputed() *pluginsdk.Schema {
 
 				"application_stack": linuxApplicationStackSchemaComputed(),
 
+				"sidecar": SidecarSchemaComputed(),
+
 				"auto_heal_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Computed: true,
@@ -964,6 +969,13 @@ func (s *SiteConfigLinux) ExpandForCreate(appSettings map[string]string) (*webap
 		}
 	}
 
+	if len(s.Sidecars) > 0 {
+		if err := ValidateSidecars(s.Sidecars); err != nil {
+			return nil, err
+		}
+		expanded.LinuxFxVersion = pointer.To(LinuxFxVersionSiteContainers)
+	}
+
 	expanded.AppSettings = ExpandAppSettingsForCreate(appSettings)
 
 	if s.ContainerRegistryMSI != "" {
@@ -1093,6 +1105,11 @@ func (s *SiteConfigLinux) ExpandForUpdate(metadata sdk.ResourceMetaData, existin
 			appSettings["DOCKER_REGISTRY_SERVER_USERNAME"] = linuxAppStack.DockerRegistryUsername
 			appSettings["DOCKER_REGISTRY_SERVER_PASSWORD"] = linuxAppStack.DockerRegistryPassword
 		}
+	} else if len(s.Sidecars) > 0 {
+		if err := ValidateSidecars(s.Sidecars); err != nil {
+			return nil, err
+		}
+		expanded.LinuxFxVersion = pointer.To(LinuxFxVersionSiteContainers)
 	} else {
 		expanded.LinuxFxVersion = pointer.To("")
 	}
@@ -1224,8 +1241,11 @@ func (s *SiteConfigLinux) Flatten(appSiteConfig *webapps.SiteConfig) {
 			var linuxAppStack ApplicationStackLinux
 			s.LinuxFxVersion = pointer.From(appSiteConfig.LinuxFxVersion)
 
-			linuxAppStack = decodeApplicationStackLinux(s.LinuxFxVersion)
-			s.ApplicationStack = []ApplicationStackLinux{linuxAppStack}
+			// Sidecar containers are read separately from the Site Containers API, so there is no application stack to decode
+			if !strings.EqualFold(s.LinuxFxVersion, LinuxFxVersionSiteContainers) {
+				linuxAppStack = decodeApplicationStackLinux(s.LinuxFxVersion)
+				s.ApplicationStack = []ApplicationStackLinux{linuxAppStack}
+			}
 		}
 	}
 }
@@ -9,16 +9,17 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 const (
-	ServicePlanTypeConsumption = "consumption"
-	ServicePlanTypeElastic     = "elastic"
-	ServicePlanTypeIsolated    = "isolated"
-	ServicePlanTypeAppPlan     = "app"
+	ServicePlanTypeConsumption     = "consumption"
+	ServicePlanTypeElastic         = "elastic"
+	ServicePlanTypeFlexConsumption = "flexconsumption"
+	ServicePlanTypeIsolated        = "isolated"
+	ServicePlanTypeAppPlan         = "app"
 )
 
 var appServicePlanSkus = []string{
@@ -42,6 +43,10 @@ var consumptionSkus = []string{
 	"Y1",
 }
 
+var flexConsumptionSkus = []string{
+	"FC1",
+}
+
 var elasticSkus = []string{
 	"EP1", "EP2", "EP3",
 }
@@ -61,6 +66,7 @@ func AllKnownServicePlanSkus() []string {
 	allSkus = append(allSkus, appServicePlanSkus...)
 	allSkus = append(allSkus, consumptionSkus...)
 	allSkus = append(allSkus, elasticSkus...)
+	allSkus = append(allSkus, flexConsumptionSkus...)
 	allSkus = append(allSkus, freeSkus...)
 	allSkus = append(allSkus, isolatedSkus...)
 	allSkus = append(allSkus, sharedSkus...)
@@ -82,6 +88,19 @@ func PlanIsConsumption(input *string) bool {
 	return false
 }
 
+func PlanIsFlexConsumption(input *string) bool {
+	if input == nil {
+		return false
+	}
+	for _, v := range flexConsumptionSkus {
+		if strings.EqualFold(*input, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func PlanIsElastic(input *string) bool {
 	if input == nil {
 		return false
@@ -130,6 +149,10 @@ func PlanTypeFromSku(input string) string {
 		return ServicePlanTypeElastic
 	}
 
+	if PlanIsFlexConsumption(&input) {
+		return ServicePlanTypeFlexConsumption
+	}
+
 	if PlanIsIsolated(&input) {
 		return ServicePlanTypeIsolated
 	}
@@ -191,6 +191,10 @@ func TestPlanTypeFromSku(t *testing.T) {
 			name:     "I1v2",
 			expected: "isolated",
 		},
+		{
+			name:     "FC1",
+			expected: "flexconsumption",
+		},
 	}
 
 	for _, v := range input {
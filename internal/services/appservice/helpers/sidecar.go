@@ -0,0 +1,492 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// LinuxFxVersionSiteContainers is the value the service expects in `linuxFxVersion` when the app is made up of Sidecar containers
+const LinuxFxVersionSiteContainers = "SITECONTAINERS"
+
+type SidecarContainer struct {
+	Name                        string                       `tfschema:"name"`
+	Image                       string                       `tfschema:"image"`
+	IsMain                      bool                         `tfschema:"is_main"`
+	TargetPort                  string                       `tfschema:"target_port"`
+	StartupCommand              string                       `tfschema:"startup_command"`
+	AuthenticationType          string                       `tfschema:"authentication_type"`
+	UserName                    string                       `tfschema:"user_name"`
+	PasswordSecret              string                       `tfschema:"password_secret"`
+	UserManagedIdentityClientId string                       `tfschema:"user_managed_identity_client_id"`
+	EnvironmentVariables        []SidecarEnvironmentVariable `tfschema:"environment_variable"`
+	VolumeMounts                []SidecarVolumeMount         `tfschema:"volume_mount"`
+}
+
+type SidecarEnvironmentVariable struct {
+	Name           string `tfschema:"name"`
+	AppSettingName string `tfschema:"app_setting_name"`
+}
+
+type SidecarVolumeMount struct {
+	VolumeSubPath      string `tfschema:"volume_sub_path"`
+	ContainerMountPath string `tfschema:"container_mount_path"`
+	Data               string `tfschema:"data"`
+	ReadOnly           bool   `tfschema:"read_only"`
+}
+
+func SidecarSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ConflictsWith: []string{
+			"site_config.0.application_stack",
+		},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Sidecar container.",
+				},
+
+				"image": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The image to use for the Sidecar container, including the registry and tag. e.g. `mcr.microsoft.com/appsvc/staticsite:latest`.",
+				},
+
+				"is_main": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Is this the main container of the app? Exactly one `sidecar` must be the main container.",
+				},
+
+				"target_port": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+$`), "`target_port` must be a port number"),
+					Description:  "The port the container listens on.",
+				},
+
+				"startup_command": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The command to run when the container starts.",
+				},
+
+				"authentication_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(webapps.AuthTypeAnonymous),
+					ValidateFunc: validation.StringInSlice(webapps.PossibleValuesForAuthType(), false),
+					Description:  "The type of authentication used to pull the image from the registry.",
+				},
+
+				"user_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The user name used to pull the image when `authentication_type` is `UserCredentials`.",
+				},
+
+				"password_secret": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The password used to pull the image when `authentication_type` is `UserCredentials`.",
+				},
+
+				"user_managed_identity_client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+					Description:  "The Client ID of the User Assigned Identity used to pull the image when `authentication_type` is `UserAssigned`.",
+				},
+
+				"environment_variable": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the environment variable.",
+							},
+
+							"app_setting_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the App Setting that holds the value of the environment variable.",
+							},
+						},
+					},
+				},
+
+				"volume_mount": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"volume_sub_path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The sub path of the volume to mount.",
+							},
+
+							"container_mount_path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The path inside the container at which the volume is mounted.",
+							},
+
+							"data": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "Configuration data written to the volume.",
+							},
+
+							"read_only": {
+								Type:        pluginsdk.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Should the volume be mounted read only?",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func SidecarSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"image": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"is_main": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"target_port": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"startup_command": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"authentication_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"user_name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"password_secret": {
+					Type:      pluginsdk.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+
+				"user_managed_identity_client_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"environment_variable": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"app_setting_name": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+
+				"volume_mount": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"volume_sub_path": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"container_mount_path": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"data": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"read_only": {
+								Type:     pluginsdk.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ValidateSidecars(input []SidecarContainer) error {
+	if len(input) == 0 {
+		return nil
+	}
+
+	mainCount := 0
+	names := make(map[string]struct{})
+	for _, v := range input {
+		if _, ok := names[strings.ToLower(v.Name)]; ok {
+			return fmt.Errorf("the `sidecar` name %q is used more than once", v.Name)
+		}
+		names[strings.ToLower(v.Name)] = struct{}{}
+
+		if v.IsMain {
+			mainCount++
+		}
+
+		switch webapps.AuthType(v.AuthenticationType) {
+		case webapps.AuthTypeUserCredentials:
+			if v.UserName == "" || v.PasswordSecret == "" {
+				return fmt.Errorf("`user_name` and `password_secret` must be set for `sidecar` %q when `authentication_type` is `%s`", v.Name, webapps.AuthTypeUserCredentials)
+			}
+		case webapps.AuthTypeUserAssigned:
+			if v.UserManagedIdentityClientId == "" {
+				return fmt.Errorf("`user_managed_identity_client_id` must be set for `sidecar` %q when `authentication_type` is `%s`", v.Name, webapps.AuthTypeUserAssigned)
+			}
+		}
+	}
+
+	if mainCount != 1 {
+		return fmt.Errorf("exactly one `sidecar` must have `is_main` set to `true`, got %d", mainCount)
+	}
+
+	return nil
+}
+
+func ExpandSidecar(input SidecarContainer) webapps.SiteContainer {
+	props := &webapps.SiteContainerProperties{
+		Image:  input.Image,
+		IsMain: input.IsMain,
+	}
+
+	if input.TargetPort != "" {
+		props.TargetPort = pointer.To(input.TargetPort)
+	}
+
+	if input.StartupCommand != "" {
+		props.StartUpCommand = pointer.To(input.StartupCommand)
+	}
+
+	if input.AuthenticationType != "" {
+		props.AuthType = pointer.To(webapps.AuthType(input.AuthenticationType))
+	}
+
+	if input.UserName != "" {
+		props.UserName = pointer.To(input.UserName)
+	}
+
+	if input.PasswordSecret != "" {
+		props.PasswordSecret = pointer.To(input.PasswordSecret)
+	}
+
+	if input.UserManagedIdentityClientId != "" {
+		props.UserManagedIdentityClientId = pointer.To(input.UserManagedIdentityClientId)
+	}
+
+	envVars := make([]webapps.EnvironmentVariable, 0)
+	for _, v := range input.EnvironmentVariables {
+		envVars = append(envVars, webapps.EnvironmentVariable{
+			Name:  v.Name,
+			Value: v.AppSettingName,
+		})
+	}
+	props.EnvironmentVariables = &envVars
+
+	volumeMounts := make([]webapps.VolumeMount, 0)
+	for _, v := range input.VolumeMounts {
+		volumeMount := webapps.VolumeMount{
+			VolumeSubPath:      v.VolumeSubPath,
+			ContainerMountPath: v.ContainerMountPath,
+			ReadOnly:           pointer.To(v.ReadOnly),
+		}
+		if v.Data != "" {
+			volumeMount.Data = pointer.To(v.Data)
+		}
+		volumeMounts = append(volumeMounts, volumeMount)
+	}
+	props.VolumeMounts = &volumeMounts
+
+	return webapps.SiteContainer{
+		Properties: props,
+	}
+}
+
+// FlattenSidecars flattens the Site Containers returned by the API, ordering them as they are in `existing` (the user's configuration)
+// since the API does not guarantee ordering. The `password_secret` is not returned by the API so is carried over from `existing`.
+func FlattenSidecars(input []webapps.SiteContainer, existing []SidecarContainer) []SidecarContainer {
+	result := make([]SidecarContainer, 0)
+	if len(input) == 0 {
+		return result
+	}
+
+	flattened := make(map[string]SidecarContainer)
+	order := make([]string, 0)
+	for _, v := range input {
+		name := pointer.From(v.Name)
+		sidecar := SidecarContainer{
+			Name: name,
+		}
+
+		if props := v.Properties; props != nil {
+			sidecar.Image = props.Image
+			sidecar.IsMain = props.IsMain
+			sidecar.TargetPort = pointer.From(props.TargetPort)
+			sidecar.StartupCommand = pointer.From(props.StartUpCommand)
+			sidecar.AuthenticationType = string(pointer.From(props.AuthType))
+			if sidecar.AuthenticationType == "" {
+				sidecar.AuthenticationType = string(webapps.AuthTypeAnonymous)
+			}
+			sidecar.UserName = pointer.From(props.UserName)
+			sidecar.UserManagedIdentityClientId = pointer.From(props.UserManagedIdentityClientId)
+
+			if envVars := props.EnvironmentVariables; envVars != nil {
+				for _, e := range *envVars {
+					sidecar.EnvironmentVariables = append(sidecar.EnvironmentVariables, SidecarEnvironmentVariable{
+						Name:           e.Name,
+						AppSettingName: e.Value,
+					})
+				}
+			}
+
+			if volumeMounts := props.VolumeMounts; volumeMounts != nil {
+				for _, m := range *volumeMounts {
+					sidecar.VolumeMounts = append(sidecar.VolumeMounts, SidecarVolumeMount{
+						VolumeSubPath:      m.VolumeSubPath,
+						ContainerMountPath: m.ContainerMountPath,
+						Data:               pointer.From(m.Data),
+						ReadOnly:           pointer.From(m.ReadOnly),
+					})
+				}
+			}
+		}
+
+		flattened[strings.ToLower(name)] = sidecar
+		order = append(order, strings.ToLower(name))
+	}
+
+	for _, e := range existing {
+		key := strings.ToLower(e.Name)
+		if v, ok := flattened[key]; ok {
+			v.PasswordSecret = e.PasswordSecret
+			result = append(result, v)
+			delete(flattened, key)
+		}
+	}
+
+	for _, key := range order {
+		if v, ok := flattened[key]; ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// UpdateSidecars creates or updates the configured Sidecar containers for the app and removes any that are no longer configured
+func UpdateSidecars(ctx context.Context, client *webapps.WebAppsClient, id commonids.AppServiceId, input []SidecarContainer) error {
+	existing, err := client.ListSiteContainersComplete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing Sidecar containers for %s: %+v", id, err)
+	}
+
+	configured := make(map[string]struct{})
+	for _, v := range input {
+		configured[strings.ToLower(v.Name)] = struct{}{}
+	}
+
+	for _, v := range existing.Items {
+		name := pointer.From(v.Name)
+		if _, ok := configured[strings.ToLower(name)]; ok || name == "" {
+			continue
+		}
+		containerId := webapps.NewSitecontainerID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, name)
+		if _, err := client.DeleteSiteContainer(ctx, containerId); err != nil {
+			return fmt.Errorf("deleting %s: %+v", containerId, err)
+		}
+	}
+
+	for _, v := range input {
+		containerId := webapps.NewSitecontainerID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, v.Name)
+		if _, err := client.CreateOrUpdateSiteContainer(ctx, containerId, ExpandSidecar(v)); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", containerId, err)
+		}
+	}
+
+	return nil
+}
+
+// ReadSidecars retrieves the Sidecar containers for the app, using `existing` to preserve ordering and values which are not returned by the API
+func ReadSidecars(ctx context.Context, client *webapps.WebAppsClient, id commonids.AppServiceId, existing []SidecarContainer) ([]SidecarContainer, error) {
+	resp, err := client.ListSiteContainersComplete(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("listing Sidecar containers for %s: %+v", id, err)
+	}
+
+	return FlattenSidecars(resp.Items, existing), nil
+}
@@ -18,7 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/migration"
@@ -55,10 +55,12 @@ type LinuxFunctionAppModel struct {
 	ClientCertMode                   string                                     `tfschema:"client_certificate_mode"`
 	ClientCertExclusionPaths         string                                     `tfschema:"client_certificate_exclusion_paths"`
 	ConnectionStrings                []helpers.ConnectionString                 `tfschema:"connection_string"`
+	DeploymentStorage                []helpers.FunctionAppDeploymentStorage     `tfschema:"deployment_storage"`
 	DailyMemoryTimeQuota             int64                                      `tfschema:"daily_memory_time_quota"` // TODO - Value ignored in for linux apps, even in Consumption plans?
 	Enabled                          bool                                       `tfschema:"enabled"`
 	FunctionExtensionsVersion        string                                     `tfschema:"functions_extension_version"`
 	ForceDisableContentShare         bool                                       `tfschema:"content_share_force_disabled"`
+	ScaleAndConcurrency              []helpers.FunctionAppScaleAndConcurrency   `tfschema:"function_app_scale_and_concurrency"`
 	HttpsOnly                        bool                                       `tfschema:"https_only"`
 	KeyVaultReferenceIdentityID      string                                     `tfschema:"key_vault_reference_identity_id"`
 	PublicNetworkAccess              bool                                       `tfschema:"public_network_access_enabled"`
@@ -283,6 +285,10 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"deployment_storage": helpers.FunctionAppDeploymentStorageSchema(),
+
+		"function_app_scale_and_concurrency": helpers.FunctionAppScaleAndConcurrencySchema(),
+
 		"site_config": helpers.SiteConfigSchemaLinuxFunctionApp(),
 
 		"sticky_settings": helpers.StickySettingsSchema(),
@@ -504,6 +510,20 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 			siteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(functionApp.SiteConfig[0].ApplicationStack)
 			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, functionApp.AppSettings)
 
+			flexConsumptionPlan := helpers.PlanIsFlexConsumption(planSKU)
+			var functionAppConfig *webapps.FunctionAppConfig
+			if flexConsumptionPlan {
+				functionAppConfig, err = helpers.ExpandFunctionAppConfig(functionApp.ScaleAndConcurrency, functionApp.DeploymentStorage, functionApp.SiteConfig[0].ApplicationStack)
+				if err != nil {
+					return fmt.Errorf("expanding Flex Consumption configuration for Linux %s: %+v", id, err)
+				}
+				// Flex Consumption apps configure the runtime through `functionAppConfig` and reject the legacy settings
+				siteConfig.LinuxFxVersion = nil
+				siteConfig.AppSettings = helpers.FilterFlexConsumptionAppSettings(siteConfig.AppSettings)
+			} else if len(functionApp.DeploymentStorage) > 0 || len(functionApp.ScaleAndConcurrency) > 0 {
+				return fmt.Errorf("`deployment_storage` and `function_app_scale_and_concurrency` can only be specified for Function Apps on a Flex Consumption Service Plan")
+			}
+
 			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(functionApp.Identity)
 			if err != nil {
 				return fmt.Errorf("expanding `identity`: %+v", err)
@@ -523,6 +543,7 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 					ClientCertMode:       pointer.To(webapps.ClientCertMode(functionApp.ClientCertMode)),
 					DailyMemoryTimeQuota: pointer.To(functionApp.DailyMemoryTimeQuota), // TODO - Investigate, setting appears silently ignored on Linux Function Apps?
 					VnetRouteAllEnabled:  siteConfig.VnetRouteAllEnabled,
+					FunctionAppConfig:    functionAppConfig,
 				},
 			}
 
@@ -802,6 +823,13 @@ func (r LinuxFunctionAppResource) Read() sdk.ResourceFunc {
 
 					state.unpackLinuxFunctionAppSettings(*appSettingsResp.Model, metadata)
 
+					if functionAppConfig := props.FunctionAppConfig; functionAppConfig != nil && functionAppConfig.Deployment != nil {
+						state.ScaleAndConcurrency, state.DeploymentStorage = helpers.FlattenFunctionAppConfig(functionAppConfig)
+						// Flex Consumption apps don't expose the runtime or extension version via App Settings
+						state.SiteConfig[0].ApplicationStack = helpers.FlattenFunctionAppFlexRuntime(functionAppConfig)
+						state.FunctionExtensionsVersion = metadata.ResourceData.Get("functions_extension_version").(string)
+					}
+
 					state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs.Model)
 
 					state.HttpsOnly = pointer.From(props.HTTPSOnly)
@@ -1033,6 +1061,20 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 
 			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
 
+			if helpers.PlanIsFlexConsumption(planSKU) {
+				if metadata.ResourceData.HasChanges("deployment_storage", "function_app_scale_and_concurrency", "site_config.0.application_stack") {
+					functionAppConfig, err := helpers.ExpandFunctionAppConfig(state.ScaleAndConcurrency, state.DeploymentStorage, state.SiteConfig[0].ApplicationStack)
+					if err != nil {
+						return fmt.Errorf("expanding Flex Consumption configuration for Linux %s: %+v", id, err)
+					}
+					model.Properties.FunctionAppConfig = functionAppConfig
+				}
+				model.Properties.SiteConfig.LinuxFxVersion = nil
+				model.Properties.SiteConfig.AppSettings = helpers.FilterFlexConsumptionAppSettings(model.Properties.SiteConfig.AppSettings)
+			} else if len(state.DeploymentStorage) > 0 || len(state.ScaleAndConcurrency) > 0 {
+				return fmt.Errorf("`deployment_storage` and `function_app_scale_and_concurrency` can only be specified for Function Apps on a Flex Consumption Service Plan")
+			}
+
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
 				pna := helpers.PublicNetworkAccessEnabled
 				if !state.PublicNetworkAccess {
@@ -27,6 +27,7 @@ const (
 	SkuStandardPlan       = "S1"
 	SkuBasicPlan          = "B1"
 	SkuPremiumPlan        = "P1v2"
+	SkuFlexConsumption    = "FC1"
 )
 
 // Plan types
@@ -574,6 +575,35 @@ func TestAccLinuxFunctionApp_consumptionComplete(t *testing.T) {
 	})
 }
 
+func TestAccLinuxFunctionApp_flexConsumption(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_function_app", "test")
+	r := LinuxFunctionAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.flexConsumption(data, 100, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.flexConsumption(data, 200, `
+    always_ready {
+      name           = "http"
+      instance_count = 1
+    }
+`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("function_app_scale_and_concurrency.0.maximum_instance_count").HasValue("200"),
+				check.That(data.ResourceName).Key("function_app_scale_and_concurrency.0.always_ready.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+	})
+}
+
 func TestAccLinuxFunctionApp_consumptionCompleteUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_linux_function_app", "test")
 	r := LinuxFunctionAppResource{}
@@ -2973,6 +3003,50 @@ resource "azurerm_linux_function_app" "test" {
 `, r.storageContainerTemplate(data, planSku), data.RandomInteger)
 }
 
+func (r LinuxFunctionAppResource) flexConsumption(data acceptance.TestData, maximumInstanceCount int, alwaysReady string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "deployments"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_linux_function_app" "test" {
+  name                = "acctest-LFA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  deployment_storage {
+    container_endpoint     = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+    authentication_type    = "StorageAccountConnectionString"
+    connection_string_name = "AzureWebJobsStorage"
+  }
+
+  function_app_scale_and_concurrency {
+    instance_memory_in_mb  = 2048
+    maximum_instance_count = %d
+%s
+  }
+
+  site_config {
+    application_stack {
+      python_version = "3.11"
+    }
+  }
+}
+`, r.template(data, SkuFlexConsumption), data.RandomInteger, maximumInstanceCount, alwaysReady)
+}
+
 func (r LinuxFunctionAppResource) consumptionComplete(data acceptance.TestData) string {
 	planSku := "Y1"
 	return fmt.Sprintf(`
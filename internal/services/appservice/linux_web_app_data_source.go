@@ -15,7 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -401,6 +401,14 @@ func (r LinuxWebAppDataSource) Read() sdk.ResourceFunc {
 					siteConfig.DecodeDockerAppStack(webApp.AppSettings)
 				}
 
+				if strings.EqualFold(siteConfig.LinuxFxVersion, helpers.LinuxFxVersionSiteContainers) {
+					sidecars, err := helpers.ReadSidecars(ctx, client, *id, nil)
+					if err != nil {
+						return err
+					}
+					siteConfig.Sidecars = sidecars
+				}
+
 				webApp.SiteConfig = []helpers.SiteConfigLinux{siteConfig}
 
 				// Filter out all settings we've consumed above
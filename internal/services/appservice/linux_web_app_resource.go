@@ -17,7 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
@@ -415,6 +415,12 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(sc.Sidecars) > 0 {
+				if err := helpers.UpdateSidecars(ctx, client, id, sc.Sidecars); err != nil {
+					return fmt.Errorf("setting Sidecar containers for Linux %s: %+v", id, err)
+				}
+			}
+
 			stickySettings := helpers.ExpandStickySettings(webApp.StickySettings)
 
 			if stickySettings != nil {
@@ -661,6 +667,22 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				siteConfig.Flatten(webAppSiteConfig.Model.Properties)
 				siteConfig.SetHealthCheckEvictionTime(state.AppSettings)
 
+				if strings.EqualFold(siteConfig.LinuxFxVersion, helpers.LinuxFxVersionSiteContainers) {
+					var existing LinuxWebAppModel
+					if err := metadata.Decode(&existing); err != nil {
+						return fmt.Errorf("decoding: %+v", err)
+					}
+					var existingSidecars []helpers.SidecarContainer
+					if len(existing.SiteConfig) > 0 {
+						existingSidecars = existing.SiteConfig[0].Sidecars
+					}
+					sidecars, err := helpers.ReadSidecars(ctx, client, *id, existingSidecars)
+					if err != nil {
+						return err
+					}
+					siteConfig.Sidecars = sidecars
+				}
+
 				// For non-import cases we check for use of the deprecated docker settings - remove in 4.0
 				_, usesDeprecatedDocker := metadata.ResourceData.GetOk("site_config.0.application_stack.0.docker_image")
 
@@ -873,6 +895,12 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				updateLogs = true
 			}
 
+			if metadata.ResourceData.HasChange("site_config.0.sidecar") {
+				if err := helpers.UpdateSidecars(ctx, client, *id, sc.Sidecars); err != nil {
+					return fmt.Errorf("updating Sidecar containers for Linux %s: %+v", id, err)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("connection_string") {
 				connectionStringUpdate := helpers.ExpandConnectionStrings(state.ConnectionStrings)
 				if connectionStringUpdate.Properties == nil {
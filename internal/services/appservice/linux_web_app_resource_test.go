@@ -1341,6 +1341,39 @@ func TestAccLinuxWebApp_withDockerImageDockerHubUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebApp_sidecar(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sidecar(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.linux_fx_version").HasValue("SITECONTAINERS"),
+				check.That(data.ResourceName).Key("site_config.0.sidecar.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.sidecarUpdate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.sidecar.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+		{
+			Config: r.sidecar(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.sidecar.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+	})
+}
+
 // Change Application stack of an app?
 
 func TestAccLinuxWebApp_updateAppStack(t *testing.T) {
@@ -3208,6 +3241,79 @@ resource "azurerm_linux_web_app" "test" {
 `, r.baseTemplate(data), data.RandomInteger, containerImage, containerTag)
 }
 
+func (r LinuxWebAppResource) sidecar(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {
+    sidecar {
+      name        = "main"
+      image       = "mcr.microsoft.com/appsvc/staticsite:latest"
+      is_main     = true
+      target_port = "80"
+    }
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
+func (r LinuxWebAppResource) sidecarUpdate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    "REDIS_HOST" = "localhost"
+  }
+
+  site_config {
+    sidecar {
+      name        = "main"
+      image       = "mcr.microsoft.com/appsvc/staticsite:latest"
+      is_main     = true
+      target_port = "80"
+
+      environment_variable {
+        name             = "REDIS_HOST"
+        app_setting_name = "REDIS_HOST"
+      }
+    }
+
+    sidecar {
+      name            = "redis"
+      image           = "mcr.microsoft.com/oss/bitnami/redis:7.0.15"
+      target_port     = "6379"
+      startup_command = "redis-server"
+
+      volume_mount {
+        volume_sub_path      = "/redis"
+        container_mount_path = "/data"
+      }
+    }
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
 func (r LinuxWebAppResource) dockerImageName(data acceptance.TestData, registryUrl, containerImage string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -182,6 +182,14 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 				Tags:     pointer.To(servicePlan.Tags),
 			}
 
+			if helpers.PlanIsFlexConsumption(pointer.To(servicePlan.Sku)) {
+				if servicePlan.OSType != OSTypeLinux {
+					return fmt.Errorf("Flex Consumption Service Plans must have an `os_type` of `%s`", OSTypeLinux)
+				}
+				appServicePlan.Kind = pointer.To("functionapp")
+				appServicePlan.Sku.Tier = pointer.To("FlexConsumption")
+			}
+
 			if servicePlan.AppServiceEnvironmentId != "" {
 				if !strings.HasPrefix(servicePlan.Sku, "I") {
 					return fmt.Errorf("App Service Environment based Service Plans can only be used with Isolated SKUs")
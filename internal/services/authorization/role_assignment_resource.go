@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -132,26 +134,151 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			// Computed since this can be generated from `storage_blob_condition`
 			"condition": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				RequiredWith: []string{"condition_version"},
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"storage_blob_condition"},
+				ValidateFunc:  validate.RoleAssignmentCondition,
 			},
 
 			"condition_version": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				RequiredWith: []string{"condition"},
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"1.0",
 					"2.0",
 				}, false),
+				DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+					// conditions created with version `1.0` are upgraded to `2.0` by the service
+					return old == "2.0" && new == "1.0"
+				},
+			},
+
+			"storage_blob_condition": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"condition"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringMatch(
+									regexp.MustCompile(`^Microsoft\.Storage/storageAccounts/blobServices/containers/blobs/.+$`),
+									"each action must be a Storage Blob data action, such as `Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read`",
+								),
+							},
+						},
+
+						"container_names": {
+							Type:         pluginsdk.TypeSet,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"storage_blob_condition.0.container_names", "storage_blob_condition.0.blob_path_prefix"},
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"blob_path_prefix": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"storage_blob_condition.0.container_names", "storage_blob_condition.0.blob_path_prefix"},
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(roleAssignmentConditionCustomizeDiff),
+		),
+	}
+}
+
+func roleAssignmentConditionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	condition := d.Get("condition").(string)
+	oldCondition, _ := d.GetChange("condition")
+
+	if v := d.Get("storage_blob_condition").([]interface{}); len(v) > 0 && v[0] != nil {
+		if !d.NewValueKnown("storage_blob_condition") {
+			return d.SetNewComputed("condition")
+		}
+
+		condition = expandRoleAssignmentStorageBlobCondition(v)
+		if oldCondition.(string) != condition {
+			if err := d.SetNew("condition", condition); err != nil {
+				return fmt.Errorf("setting `condition`: %+v", err)
+			}
+		}
+	} else if d.NewValueKnown("condition") && d.GetRawConfig().GetAttr("condition").IsNull() && oldCondition.(string) != "" {
+		// as `condition` is Computed, removing it from the configuration needs to be handled explicitly
+		condition = ""
+		if err := d.SetNew("condition", ""); err != nil {
+			return fmt.Errorf("setting `condition`: %+v", err)
+		}
+	}
+
+	if !d.NewValueKnown("condition") {
+		return nil
+	}
+
+	oldConditionVersion, _ := d.GetChange("condition_version")
+	conditionVersionConfigured := !d.GetRawConfig().GetAttr("condition_version").IsNull()
+	if condition == "" {
+		if conditionVersionConfigured {
+			return fmt.Errorf("`condition_version` can only be specified when `condition` or `storage_blob_condition` is set")
+		}
+		if oldConditionVersion.(string) != "" {
+			return d.SetNew("condition_version", "")
+		}
+	} else if !conditionVersionConfigured && oldConditionVersion.(string) == "" {
+		return d.SetNew("condition_version", "2.0")
 	}
+
+	return nil
+}
+
+// expandRoleAssignmentStorageBlobCondition builds an ABAC condition which restricts the specified actions to the
+// specified containers and/or blob path prefix, in the same format as the Azure Portal's condition builder.
+func expandRoleAssignmentStorageBlobCondition(input []interface{}) string {
+	raw := input[0].(map[string]interface{})
+
+	actions := make([]string, 0)
+	for _, action := range raw["actions"].(*pluginsdk.Set).List() {
+		actions = append(actions, fmt.Sprintf("!(ActionMatches{'%s'})", action.(string)))
+	}
+	sort.Strings(actions)
+
+	expressions := make([]string, 0)
+
+	containerNames := make([]string, 0)
+	for _, name := range raw["container_names"].(*pluginsdk.Set).List() {
+		containerNames = append(containerNames, fmt.Sprintf("@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals '%s'", name.(string)))
+	}
+	sort.Strings(containerNames)
+	if len(containerNames) > 0 {
+		expressions = append(expressions, fmt.Sprintf("(%s)", strings.Join(containerNames, " OR ")))
+	}
+
+	if prefix := raw["blob_path_prefix"].(string); prefix != "" {
+		expressions = append(expressions, fmt.Sprintf("(@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:path] StringStartsWith '%s')", prefix))
+	}
+
+	return fmt.Sprintf("((%s) OR (%s))", strings.Join(actions, " AND "), strings.Join(expressions, " AND "))
 }
 
 func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -234,13 +361,19 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	condition := d.Get("condition").(string)
+	if v := d.Get("storage_blob_condition").([]interface{}); len(v) > 0 && v[0] != nil {
+		condition = expandRoleAssignmentStorageBlobCondition(v)
+	}
 	conditionVersion := d.Get("condition_version").(string)
 
-	if condition != "" && conditionVersion != "" {
+	if condition != "" {
+		if conditionVersion == "" {
+			conditionVersion = "2.0"
+		}
 		properties.RoleAssignmentProperties.Condition = utils.String(condition)
 		properties.RoleAssignmentProperties.ConditionVersion = utils.String(conditionVersion)
-	} else if condition != "" || conditionVersion != "" {
-		return fmt.Errorf("`condition` and `conditionVersion` should be both set or unset")
+	} else if conditionVersion != "" {
+		return fmt.Errorf("`condition_version` can only be specified when `condition` or `storage_blob_condition` is set")
 	}
 
 	skipPrincipalCheck := d.Get("skip_service_principal_aad_check").(bool)
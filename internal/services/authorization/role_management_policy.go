@@ -233,10 +233,16 @@ func buildRoleManagementPolicyForUpdate(metadata *sdk.ResourceMetaData, rolePoli
 			}
 
 			var approvalReqd bool
-			var approvalStages []map[string]interface{}
+			var approvalStages interface{}
+
+			// retain any settings which aren't managed by this resource, such as the `approvalMode`
+			updatedSettings := make(map[string]interface{})
 
 			if settingsRaw, ok := approvalEndUserAssignment.Values["setting"]; ok {
 				settings := settingsRaw.(map[string]interface{})
+				for k, v := range settings {
+					updatedSettings[k] = v
+				}
 
 				if approvalReqdRaw, ok := settings["isApprovalRequired"]; ok {
 					approvalReqd = approvalReqdRaw.(bool)
@@ -248,30 +254,43 @@ func buildRoleManagementPolicyForUpdate(metadata *sdk.ResourceMetaData, rolePoli
 					}
 				}
 
-				if metadata.ResourceData.HasChange("activation_rules.0.approval_stage") {
-					if len(model.ActivationRules) == 1 {
-						approvalStages = make([]map[string]interface{}, len(model.ActivationRules[0].ApprovalStages))
-						for i, stage := range model.ActivationRules[0].ApprovalStages {
-							primaryApprovers := make([]map[string]interface{}, len(stage.PrimaryApprovers))
-							for ia, approver := range stage.PrimaryApprovers {
-								primaryApprovers[ia] = map[string]interface{}{
-									"id":       approver.ID,
-									"userType": approver.Type,
-								}
-							}
+				if approvalStagesRaw, ok := settings["approvalStages"]; ok {
+					approvalStages = approvalStagesRaw
+				}
+			}
 
-							approvalStages[i] = map[string]interface{}{
-								"PrimaryApprovers": primaryApprovers,
-							}
+			if metadata.ResourceData.HasChange("activation_rules.0.approval_stage") && len(model.ActivationRules) == 1 {
+				existingStages, _ := approvalStages.([]interface{})
+
+				stages := make([]interface{}, len(model.ActivationRules[0].ApprovalStages))
+				for i, stage := range model.ActivationRules[0].ApprovalStages {
+					primaryApprovers := make([]interface{}, len(stage.PrimaryApprovers))
+					for ia, approver := range stage.PrimaryApprovers {
+						primaryApprovers[ia] = map[string]interface{}{
+							"id":       approver.ID,
+							"userType": approver.Type,
 						}
 					}
-				} else {
-					if approvalStagesRaw, ok := settings["approvalStages"]; ok {
-						approvalStages = approvalStagesRaw.([]map[string]interface{})
+
+					// retain the existing settings for the stage (e.g. the timeout and escalation), replacing only the approvers
+					updatedStage := make(map[string]interface{})
+					if i < len(existingStages) {
+						if existingStage, ok := existingStages[i].(map[string]interface{}); ok {
+							for k, v := range existingStage {
+								updatedStage[k] = v
+							}
+						}
 					}
+					updatedStage["primaryApprovers"] = primaryApprovers
+
+					stages[i] = updatedStage
 				}
+				approvalStages = stages
 			}
 
+			updatedSettings["isApprovalRequired"] = approvalReqd
+			updatedSettings["approvalStages"] = approvalStages
+
 			var id, ruleType string
 			var target map[string]interface{}
 			if idRaw, ok := approvalEndUserAssignment.Values["id"]; ok {
@@ -288,10 +307,7 @@ func buildRoleManagementPolicyForUpdate(metadata *sdk.ResourceMetaData, rolePoli
 				"id":       id,
 				"ruleType": ruleType,
 				"target":   target,
-				"setting": map[string]interface{}{
-					"isApprovalRequired": approvalReqd,
-					"approvalStages":     approvalStages,
-				},
+				"setting":  updatedSettings,
 			})
 		}
 	}
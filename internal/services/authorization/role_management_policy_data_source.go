@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -112,6 +113,7 @@ func (r RoleManagementPolicyDataSource) Arguments() map[string]*pluginsdk.Schema
 				commonids.ValidateManagementGroupID,
 				commonids.ValidateResourceGroupID,
 				commonids.ValidateSubscriptionID,
+				azure.ValidateResourceID,
 			),
 		},
 	}
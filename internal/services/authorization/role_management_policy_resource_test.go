@@ -69,6 +69,24 @@ func TestAccRoleManagementPolicy_resourceGroup(t *testing.T) {
 	})
 }
 
+func TestAccRoleManagementPolicy_resource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	// Ignore the dangling resource post-test as the policy remains while the storage account exists, or is in a pending deletion state
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.resource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation_rules.0.maximum_duration").HasValue("PT2H"),
+				check.That(data.ResourceName).Key("activation_rules.0.require_approval").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccRoleManagementPolicy_subscription(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_management_policy", "test")
 	r := RoleManagementPolicyResource{}
@@ -287,6 +305,49 @@ resource "azurerm_role_management_policy" "test" {
 `, r.resourceGroupTemplate(data), data.RandomString)
 }
 
+func (r RoleManagementPolicyResource) resource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+provider "azuread" {}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurerm_role_definition" "storage_account_contributor" {
+  name  = "Contributor"
+  scope = azurerm_storage_account.test.id
+}
+
+resource "azuread_group" "approver" {
+  display_name     = "PIM Approver Test %[2]s"
+  mail_enabled     = false
+  security_enabled = true
+}
+
+resource "azurerm_role_management_policy" "test" {
+  scope              = azurerm_storage_account.test.id
+  role_definition_id = data.azurerm_role_definition.storage_account_contributor.id
+
+  activation_rules {
+    maximum_duration = "PT2H"
+    require_approval = true
+    approval_stage {
+      primary_approver {
+        object_id = azuread_group.approver.object_id
+        type      = "Group"
+      }
+    }
+  }
+}
+`, r.resourceGroupTemplate(data), data.RandomString)
+}
+
 func (RoleManagementPolicyResource) subscriptionTemplate(data acceptance.TestData) string {
 	return `
 provider "azurerm" {}
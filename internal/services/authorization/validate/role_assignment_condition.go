@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var roleAssignmentConditionAttributeRegex = regexp.MustCompile(`@(Request|Resource|Principal|Environment)\[[^\]]+\]`)
+
+// RoleAssignmentCondition performs a structural check of an ABAC condition expression, so that obvious
+// mistakes (such as unbalanced brackets or quotes) are surfaced at plan time rather than by the API.
+func RoleAssignmentCondition(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	closing := map[rune]rune{')': '(', '}': '{', ']': '['}
+	stack := make([]rune, 0)
+	inQuotes := false
+	for _, c := range v {
+		if c == '\'' {
+			inQuotes = !inQuotes
+			continue
+		}
+		if inQuotes {
+			continue
+		}
+
+		switch c {
+		case '(', '{', '[':
+			stack = append(stack, c)
+		case ')', '}', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != closing[c] {
+				errors = append(errors, fmt.Errorf("%q contains an unexpected %q", k, string(c)))
+				return
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if inQuotes {
+		errors = append(errors, fmt.Errorf("%q contains an unterminated quoted string", k))
+		return
+	}
+
+	if len(stack) != 0 {
+		errors = append(errors, fmt.Errorf("%q contains an unclosed %q", k, string(stack[len(stack)-1])))
+		return
+	}
+
+	if !strings.Contains(v, "ActionMatches") && !roleAssignmentConditionAttributeRegex.MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must contain an `ActionMatches` expression or reference an attribute such as `@Resource[...]`", k))
+	}
+
+	return
+}
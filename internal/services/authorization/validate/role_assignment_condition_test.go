@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestRoleAssignmentCondition(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "   ",
+			Valid: false,
+		},
+		{
+			Input: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'examplecontainer'))",
+			Valid: true,
+		},
+		{
+			// a closing bracket inside a quoted string is ignored
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:path] StringStartsWith 'logs)'",
+			Valid: true,
+		},
+		{
+			Input: "@Principal[Microsoft.Directory/CustomSecurityAttributes/Id:Engineering_Project] StringEquals 'Cascade'",
+			Valid: true,
+		},
+		{
+			// unbalanced parentheses
+			Input: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'examplecontainer')",
+			Valid: false,
+		},
+		{
+			// mismatched brackets
+			Input: "(!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read')}",
+			Valid: false,
+		},
+		{
+			// unterminated string
+			Input: "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'examplecontainer",
+			Valid: false,
+		},
+		{
+			// no action or attribute
+			Input: "(true)",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := RoleAssignmentCondition(tc.Input, "condition")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
@@ -150,7 +150,7 @@ func resourceAutomationSchedule() *pluginsdk.Resource {
 			"monthly_occurrence": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				MaxItems: 1,
+				MaxItems: 5,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"day": {
@@ -202,11 +202,45 @@ func resourceAutomationSchedule() *pluginsdk.Resource {
 				return fmt.Errorf("`month_days` can only be set when frequency is `Month`")
 			}
 
-			_, hasMonthlyOccurrences := diff.GetOk("monthly_occurrence")
+			monthlyOccurrences, hasMonthlyOccurrences := diff.GetOk("monthly_occurrence")
 			if hasMonthlyOccurrences && frequency != "month" {
 				return fmt.Errorf("`monthly_occurrence` can only be set when frequency is `Month`")
 			}
 
+			if hasMonthlyOccurrences {
+				seen := make(map[string]bool)
+				for _, raw := range monthlyOccurrences.([]interface{}) {
+					if raw == nil {
+						continue
+					}
+					occurrence := raw.(map[string]interface{})
+					key := fmt.Sprintf("%s/%d", strings.ToLower(occurrence["day"].(string)), occurrence["occurrence"].(int))
+					if seen[key] {
+						return fmt.Errorf("`monthly_occurrence` contains a duplicate block for `day` %q and `occurrence` %d", occurrence["day"].(string), occurrence["occurrence"].(int))
+					}
+					seen[key] = true
+				}
+			}
+
+			// the API rejects schedules which expire before they start
+			if diff.NewValueKnown("start_time") && diff.NewValueKnown("expiry_time") {
+				startTime, startTimeOk := diff.GetOk("start_time")
+				expiryTime, expiryTimeOk := diff.GetOk("expiry_time")
+				if startTimeOk && expiryTimeOk && !diff.GetRawConfig().GetAttr("expiry_time").IsNull() {
+					start, err := time.Parse(time.RFC3339, startTime.(string))
+					if err != nil {
+						return nil
+					}
+					expiry, err := time.Parse(time.RFC3339, expiryTime.(string))
+					if err != nil {
+						return nil
+					}
+					if frequency != "onetime" && !expiry.After(start) {
+						return fmt.Errorf("`expiry_time` (%s) must be after `start_time` (%s)", expiryTime.(string), startTime.(string))
+					}
+				}
+			}
+
 			return nil
 		}),
 	}
@@ -530,8 +564,8 @@ func flattenArmAutomationScheduleAdvancedMonthlyOccurrences(s *schedule.Advanced
 	if monthlyOccurrences := s.MonthlyOccurrences; monthlyOccurrences != nil {
 		for _, v := range *monthlyOccurrences {
 			f := make(map[string]interface{})
-			f["day"] = v.Day
-			f["occurrence"] = int(*v.Occurrence)
+			f["day"] = string(pointer.From(v.Day))
+			f["occurrence"] = int(pointer.From(v.Occurrence))
 			flattenedMonthlyOccurrences = append(flattenedMonthlyOccurrences, f)
 		}
 	}
@@ -6,6 +6,7 @@ package automation_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -224,6 +225,46 @@ func TestAccAutomationSchedule_monthly_advanced_by_week_day(t *testing.T) {
 	})
 }
 
+func TestAccAutomationSchedule_monthly_advanced_multiple_week_days(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_schedule", "test")
+	r := AutomationScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recurring_advanced_month_week_day(data, "Monday", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.recurring_advanced_month_multiple_week_days(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("monthly_occurrence.#").HasValue("2"),
+				check.That(data.ResourceName).Key("monthly_occurrence.1.occurrence").HasValue("-1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationSchedule_invalidAdvancedSchedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_schedule", "test")
+	r := AutomationScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.recurring_advanced_month_duplicate_week_days(data),
+			ExpectError: regexp.MustCompile("`monthly_occurrence` contains a duplicate block"),
+		},
+		{
+			Config:      r.recurring_expiry_before_start(data),
+			ExpectError: regexp.MustCompile("`expiry_time` .* must be after `start_time`"),
+		},
+	})
+}
+
 func (t AutomationScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := schedule.ParseScheduleID(state.ID)
 	if err != nil {
@@ -381,3 +422,71 @@ resource "azurerm_automation_schedule" "test" {
 }
 `, AutomationScheduleResource{}.template(data), data.RandomInteger, weekDay, weekDayOccurrence)
 }
+
+func (AutomationScheduleResource) recurring_advanced_month_multiple_week_days(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_schedule" "test" {
+  name                    = "acctestAS-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  frequency               = "Month"
+  interval                = "1"
+  timezone                = "Europe/London"
+
+  monthly_occurrence {
+    day        = "Monday"
+    occurrence = 1
+  }
+
+  monthly_occurrence {
+    day        = "Sunday"
+    occurrence = -1
+  }
+}
+`, AutomationScheduleResource{}.template(data), data.RandomInteger)
+}
+
+func (AutomationScheduleResource) recurring_advanced_month_duplicate_week_days(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_schedule" "test" {
+  name                    = "acctestAS-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  frequency               = "Month"
+  interval                = "1"
+
+  monthly_occurrence {
+    day        = "Sunday"
+    occurrence = -1
+  }
+
+  monthly_occurrence {
+    day        = "Sunday"
+    occurrence = -1
+  }
+}
+`, AutomationScheduleResource{}.template(data), data.RandomInteger)
+}
+
+func (AutomationScheduleResource) recurring_expiry_before_start(data acceptance.TestData) string {
+	startTime := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+	expiryTime := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_schedule" "test" {
+  name                    = "acctestAS-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  frequency               = "Day"
+  interval                = "1"
+  start_time              = "%s"
+  expiry_time             = "%s"
+}
+`, AutomationScheduleResource{}.template(data), data.RandomInteger, startTime, expiryTime)
+}
@@ -11,7 +11,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/pool"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-02-01/pool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -792,6 +792,10 @@ func expandBatchPoolVirtualMachineConfig(d *pluginsdk.ResourceData) (*pool.Virtu
 		result.OsDisk = expandBatchPoolOSDisk(v)
 	}
 
+	if v, ok := d.GetOk("security_profile"); ok {
+		result.SecurityProfile = expandBatchPoolSecurityProfile(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("windows"); ok {
 		result.WindowsConfiguration = expandBatchPoolWindowsConfiguration(v.([]interface{}))
 	}
@@ -799,6 +803,133 @@ func expandBatchPoolVirtualMachineConfig(d *pluginsdk.ResourceData) (*pool.Virtu
 	return &result, nil
 }
 
+func expandBatchPoolSecurityProfile(list []interface{}) *pool.SecurityProfile {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	item := list[0].(map[string]interface{})
+	result := &pool.SecurityProfile{
+		EncryptionAtHost: pointer.To(item["host_encryption_enabled"].(bool)),
+		UefiSettings: &pool.UefiSettings{
+			SecureBootEnabled: pointer.To(item["secure_boot_enabled"].(bool)),
+			VTpmEnabled:       pointer.To(item["vtpm_enabled"].(bool)),
+		},
+	}
+
+	if v := item["security_type"].(string); v != "" {
+		result.SecurityType = pointer.To(pool.SecurityTypes(v))
+	}
+
+	return result
+}
+
+func flattenBatchPoolSecurityProfile(input *pool.SecurityProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	secureBootEnabled := false
+	vTpmEnabled := false
+	if input.UefiSettings != nil {
+		secureBootEnabled = pointer.From(input.UefiSettings.SecureBootEnabled)
+		vTpmEnabled = pointer.From(input.UefiSettings.VTpmEnabled)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"host_encryption_enabled": pointer.From(input.EncryptionAtHost),
+			"security_type":           string(pointer.From(input.SecurityType)),
+			"secure_boot_enabled":     secureBootEnabled,
+			"vtpm_enabled":            vTpmEnabled,
+		},
+	}
+}
+
+func expandBatchPoolUpgradePolicy(list []interface{}) *pool.UpgradePolicy {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	item := list[0].(map[string]interface{})
+	result := &pool.UpgradePolicy{
+		Mode: pool.UpgradeMode(item["mode"].(string)),
+	}
+
+	if v := item["automatic_os_upgrade_policy"].([]interface{}); len(v) > 0 && v[0] != nil {
+		policy := v[0].(map[string]interface{})
+		result.AutomaticOSUpgradePolicy = &pool.AutomaticOSUpgradePolicy{
+			DisableAutomaticRollback: pointer.To(!policy["automatic_rollback_enabled"].(bool)),
+			EnableAutomaticOSUpgrade: pointer.To(policy["automatic_os_upgrade_enabled"].(bool)),
+			OsRollingUpgradeDeferral: pointer.To(policy["os_rolling_upgrade_deferral_enabled"].(bool)),
+			UseRollingUpgradePolicy:  pointer.To(policy["use_rolling_upgrade_policy_enabled"].(bool)),
+		}
+	}
+
+	if v := item["rolling_upgrade_policy"].([]interface{}); len(v) > 0 && v[0] != nil {
+		policy := v[0].(map[string]interface{})
+		rollingUpgradePolicy := &pool.RollingUpgradePolicy{
+			EnableCrossZoneUpgrade:                pointer.To(policy["cross_zone_upgrade_enabled"].(bool)),
+			PrioritizeUnhealthyInstances:          pointer.To(policy["prioritize_unhealthy_instances_enabled"].(bool)),
+			RollbackFailedInstancesOnPolicyBreach: pointer.To(policy["rollback_failed_instances_on_policy_breach_enabled"].(bool)),
+		}
+
+		if v := policy["max_batch_instance_percent"].(int); v != 0 {
+			rollingUpgradePolicy.MaxBatchInstancePercent = pointer.To(int64(v))
+		}
+		if v := policy["max_unhealthy_instance_percent"].(int); v != 0 {
+			rollingUpgradePolicy.MaxUnhealthyInstancePercent = pointer.To(int64(v))
+		}
+		if v := policy["max_unhealthy_upgraded_instance_percent"].(int); v != 0 {
+			rollingUpgradePolicy.MaxUnhealthyUpgradedInstancePercent = pointer.To(int64(v))
+		}
+		if v := policy["pause_time_between_batches"].(string); v != "" {
+			rollingUpgradePolicy.PauseTimeBetweenBatches = pointer.To(v)
+		}
+
+		result.RollingUpgradePolicy = rollingUpgradePolicy
+	}
+
+	return result
+}
+
+func flattenBatchPoolUpgradePolicy(input *pool.UpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	automaticOSUpgradePolicy := make([]interface{}, 0)
+	if policy := input.AutomaticOSUpgradePolicy; policy != nil {
+		automaticOSUpgradePolicy = append(automaticOSUpgradePolicy, map[string]interface{}{
+			"automatic_os_upgrade_enabled":        pointer.From(policy.EnableAutomaticOSUpgrade),
+			"automatic_rollback_enabled":          !pointer.From(policy.DisableAutomaticRollback),
+			"os_rolling_upgrade_deferral_enabled": pointer.From(policy.OsRollingUpgradeDeferral),
+			"use_rolling_upgrade_policy_enabled":  pointer.From(policy.UseRollingUpgradePolicy),
+		})
+	}
+
+	rollingUpgradePolicy := make([]interface{}, 0)
+	if policy := input.RollingUpgradePolicy; policy != nil {
+		rollingUpgradePolicy = append(rollingUpgradePolicy, map[string]interface{}{
+			"cross_zone_upgrade_enabled":                         pointer.From(policy.EnableCrossZoneUpgrade),
+			"max_batch_instance_percent":                         int(pointer.From(policy.MaxBatchInstancePercent)),
+			"max_unhealthy_instance_percent":                     int(pointer.From(policy.MaxUnhealthyInstancePercent)),
+			"max_unhealthy_upgraded_instance_percent":            int(pointer.From(policy.MaxUnhealthyUpgradedInstancePercent)),
+			"pause_time_between_batches":                         pointer.From(policy.PauseTimeBetweenBatches),
+			"prioritize_unhealthy_instances_enabled":             pointer.From(policy.PrioritizeUnhealthyInstances),
+			"rollback_failed_instances_on_policy_breach_enabled": pointer.From(policy.RollbackFailedInstancesOnPolicyBreach),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                        string(input.Mode),
+			"automatic_os_upgrade_policy": automaticOSUpgradePolicy,
+			"rolling_upgrade_policy":      rollingUpgradePolicy,
+		},
+	}
+}
+
 func expandBatchPoolOSDisk(ref interface{}) *pool.OSDisk {
 	if ref == nil {
 		return nil
@@ -17,10 +17,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/pool"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-02-01/pool"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
@@ -49,6 +50,14 @@ func resourceBatchPool() *pluginsdk.Resource {
 			_, err := pool.ParsePoolID(id)
 			return err
 		}),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the Update API ignores an omitted `upgradePolicy`, so removing it requires the pool to be recreated
+			pluginsdk.ForceNewIfChange("upgrade_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -714,6 +723,37 @@ func resourceBatchPool() *pluginsdk.Resource {
 						string(pool.DiffDiskPlacementCacheDisk),
 					}, false),
 			},
+			"security_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"security_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForSecurityTypes(), false),
+						},
+						"secure_boot_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"vtpm_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"inter_node_communication": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -812,6 +852,87 @@ func resourceBatchPool() *pluginsdk.Resource {
 					},
 				},
 			},
+			"upgrade_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForUpgradeMode(), false),
+						},
+						"automatic_os_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"automatic_os_upgrade_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"automatic_rollback_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"os_rolling_upgrade_deferral_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"use_rolling_upgrade_policy_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"rolling_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"cross_zone_upgrade_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"max_batch_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"max_unhealthy_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"max_unhealthy_upgraded_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"pause_time_between_batches": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+									},
+									"prioritize_unhealthy_instances_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"rollback_failed_instances_on_policy_breach_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"windows": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -980,6 +1101,8 @@ func resourceBatchPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		parameters.Properties.TargetNodeCommunicationMode = pointer.To(pool.NodeCommunicationMode(v.(string)))
 	}
 
+	parameters.Properties.UpgradePolicy = expandBatchPoolUpgradePolicy(d.Get("upgrade_policy").([]interface{}))
+
 	_, err = client.Create(ctx, id, parameters, pool.CreateOperationOptions{})
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -1112,6 +1235,10 @@ func resourceBatchPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		parameters.Properties.TargetNodeCommunicationMode = pointer.To(pool.NodeCommunicationMode(d.Get("target_node_communication_mode").(string)))
 	}
 
+	if d.HasChange("upgrade_policy") {
+		parameters.Properties.UpgradePolicy = expandBatchPoolUpgradePolicy(d.Get("upgrade_policy").([]interface{}))
+	}
+
 	result, err := client.Update(ctx, *id, parameters, pool.UpdateOperationOptions{})
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
@@ -1290,6 +1417,7 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 						osDiskPlacement = string(*config.OsDisk.EphemeralOSDiskSettings.Placement)
 					}
 					d.Set("os_disk_placement", osDiskPlacement)
+					d.Set("security_profile", flattenBatchPoolSecurityProfile(config.SecurityProfile))
 					if config.WindowsConfiguration != nil {
 						windowsConfig := []interface{}{
 							map[string]interface{}{
@@ -1322,6 +1450,10 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 			d.Set("target_node_communication_mode", targetNodeCommunicationMode)
 
+			if err := d.Set("upgrade_policy", flattenBatchPoolUpgradePolicy(props.UpgradePolicy)); err != nil {
+				return fmt.Errorf("setting `upgrade_policy`: %v", err)
+			}
+
 			if err := d.Set("network_configuration", flattenBatchPoolNetworkConfiguration(props.NetworkConfiguration)); err != nil {
 				return fmt.Errorf("setting `network_configuration`: %v", err)
 			}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceCdnFrontDoorPurge purges cached content from a Front Door Endpoint when it is created, the purge itself
+// isn't retained by the service so only the Endpoint is checked when refreshing and nothing is removed on deletion.
+func resourceCdnFrontDoorPurge() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorPurgeCreate,
+		Read:   resourceCdnFrontDoorPurgeRead,
+		Delete: resourceCdnFrontDoorPurgeDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"cdn_frontdoor_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorEndpointID,
+			},
+
+			"content_paths": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validateCdnFrontDoorPurgeContentPath,
+				},
+			},
+
+			"domains": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorPurgeCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorEndpointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := parse.FrontDoorEndpointID(d.Get("cdn_frontdoor_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	purgeName, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating UUID: %+v", err)
+	}
+
+	id := parse.NewFrontDoorPurgeID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, purgeName)
+
+	params := cdn.AfdPurgeParameters{
+		ContentPaths: utils.ExpandStringSlice(d.Get("content_paths").([]interface{})),
+	}
+
+	if domains := d.Get("domains").([]interface{}); len(domains) > 0 {
+		params.Domains = utils.ExpandStringSlice(domains)
+	}
+
+	log.Printf("[INFO] purging content from %s", endpointId)
+	future, err := client.PurgeContent(ctx, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, params)
+	if err != nil {
+		return fmt.Errorf("purging content from %s: %+v", endpointId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the content purge of %s: %+v", endpointId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorPurgeRead(d, meta)
+}
+
+func resourceCdnFrontDoorPurgeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorPurgeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	endpointId := parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
+
+	resp, err := client.Get(ctx, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", endpointId, id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", endpointId, err)
+	}
+
+	d.Set("cdn_frontdoor_endpoint_id", endpointId.ID())
+
+	return nil
+}
+
+func resourceCdnFrontDoorPurgeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	// a purge can't be undone, so there is nothing to do other than remove it from the state
+	return nil
+}
+
+func validateCdnFrontDoorPurgeContentPath(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !strings.HasPrefix(v, "/") {
+		errors = append(errors, fmt.Errorf("%q must be a path starting with a `/`, such as `/pictures/city.png` or `/pictures/*`, got %q", k, v))
+	}
+
+	return
+}
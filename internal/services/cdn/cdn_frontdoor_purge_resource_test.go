@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorPurgeResource struct{}
+
+func TestAccCdnFrontDoorPurge_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_purge", "test")
+	r := CdnFrontDoorPurgeResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccCdnFrontDoorPurge_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_purge", "test")
+	r := CdnFrontDoorPurgeResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.triggers(data, "v1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.triggers(data, "v2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("triggers.release").HasValue("v2"),
+			),
+		},
+	})
+}
+
+func TestAccCdnFrontDoorPurge_invalidContentPath(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_purge", "test")
+	r := CdnFrontDoorPurgeResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidContentPath(data),
+			ExpectError: regexp.MustCompile("must be a path starting with a `/`"),
+		},
+	})
+}
+
+func (r CdnFrontDoorPurgeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FrontDoorPurgeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.Cdn.FrontDoorEndpointsClient
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r CdnFrontDoorPurgeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_purge" "test" {
+  cdn_frontdoor_endpoint_id = azurerm_cdn_frontdoor_endpoint.test.id
+  content_paths             = ["/*"]
+}
+`, r.template(data))
+}
+
+func (r CdnFrontDoorPurgeResource) triggers(data acceptance.TestData, release string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_purge" "test" {
+  cdn_frontdoor_endpoint_id = azurerm_cdn_frontdoor_endpoint.test.id
+  content_paths             = ["/images/*", "/index.html"]
+  domains                   = [azurerm_cdn_frontdoor_endpoint.test.host_name]
+
+  triggers = {
+    release = "%s"
+  }
+}
+`, r.template(data), release)
+}
+
+func (r CdnFrontDoorPurgeResource) invalidContentPath(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_purge" "test" {
+  cdn_frontdoor_endpoint_id = azurerm_cdn_frontdoor_endpoint.test.id
+  content_paths             = ["images/*"]
+}
+`, r.template(data))
+}
+
+func (r CdnFrontDoorPurgeResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cdn-afdx-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cdn_frontdoor_profile" "test" {
+  name                = "acctest-cdnfdprofile-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard_AzureFrontDoor"
+}
+
+resource "azurerm_cdn_frontdoor_endpoint" "test" {
+  name                     = "acctest-cdnfdendpoint-%[1]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -81,7 +81,7 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeList,
 										Required: true,
 										ForceNew: true,
-										MaxItems: 1,
+										MaxItems: 100,
 
 										Elem: &pluginsdk.Resource{
 											Schema: map[string]*pluginsdk.Schema{
@@ -110,18 +110,15 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 													},
 												},
 
-												// NOTE: Per the service team the only acceptable value as of GA is "/*"
 												"patterns_to_match": {
 													Type:     pluginsdk.TypeList,
 													Required: true,
 													ForceNew: true,
-													MaxItems: 1,
+													MaxItems: 25,
 
 													Elem: &pluginsdk.Schema{
-														Type: pluginsdk.TypeString,
-														ValidateFunc: validation.StringInSlice([]string{
-															"/*",
-														}, false),
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validate.FrontDoorSecurityPolicyPatternToMatch,
 													},
 												},
 											},
@@ -5,6 +5,7 @@ package cdnfrontdoorsecurityparams
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
@@ -41,28 +42,46 @@ func ExpandCdnFrontdoorFirewallPolicyParameters(input []interface{}, isStandardS
 
 	configAssociations := v["association"].([]interface{})
 
+	// the domain limits apply to the security policy as a whole, so these are counted across all of the associations
+	totalDomains := 0
+	associatedPatterns := make(map[string]struct{})
+
 	for _, item := range configAssociations {
 		v := item.(map[string]interface{})
 		domains := expandSecurityPoliciesActivatedResourceReference(v["domain"].([]interface{}))
-
-		if isStandardSku {
-			if len(*domains) > 100 {
-				return &results, fmt.Errorf("the 'Standard_AzureFrontDoor' sku is only allowed to have 100 or less domains associated with the firewall policy, got %d", len(*domains))
-			}
-		} else {
-			if len(*domains) > 500 {
-				return &results, fmt.Errorf("the 'Premium_AzureFrontDoor' sku is only allowed to have 500 or less domains associated with the firewall policy, got %d", len(*domains))
+		patterns := utils.ExpandStringSlice(v["patterns_to_match"].([]interface{}))
+
+		totalDomains += len(*domains)
+
+		// the same domain can be associated more than once, but only for different paths
+		for _, domain := range *domains {
+			for _, pattern := range *patterns {
+				key := strings.ToLower(fmt.Sprintf("%s|%s", *domain.ID, pattern))
+				if _, exists := associatedPatterns[key]; exists {
+					return &results, fmt.Errorf("the domain %q is associated with the pattern %q more than once", *domain.ID, pattern)
+				}
+				associatedPatterns[key] = struct{}{}
 			}
 		}
 
 		association := cdn.SecurityPolicyWebApplicationFirewallAssociation{
 			Domains:         domains,
-			PatternsToMatch: utils.ExpandStringSlice(v["patterns_to_match"].([]interface{})),
+			PatternsToMatch: patterns,
 		}
 
 		associations = append(associations, association)
 	}
 
+	if isStandardSku {
+		if totalDomains > 100 {
+			return &results, fmt.Errorf("the 'Standard_AzureFrontDoor' sku is only allowed to have 100 or less domains associated with the firewall policy, got %d", totalDomains)
+		}
+	} else {
+		if totalDomains > 500 {
+			return &results, fmt.Errorf("the 'Premium_AzureFrontDoor' sku is only allowed to have 500 or less domains associated with the firewall policy, got %d", totalDomains)
+		}
+	}
+
 	results.Associations = &associations
 
 	return &results, nil
@@ -61,6 +61,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cdn_frontdoor_origin":                               resourceCdnFrontDoorOrigin(),
 		"azurerm_cdn_frontdoor_origin_group":                         resourceCdnFrontDoorOriginGroup(),
 		"azurerm_cdn_frontdoor_profile":                              resourceCdnFrontDoorProfile(),
+		"azurerm_cdn_frontdoor_purge":                                resourceCdnFrontDoorPurge(),
 		"azurerm_cdn_frontdoor_route":                                resourceCdnFrontDoorRoute(),
 		"azurerm_cdn_frontdoor_route_disable_link_to_default_domain": resourceCdnFrontDoorRouteDisableLinkToDefaultDomain(),
 		"azurerm_cdn_frontdoor_rule":                                 resourceCdnFrontDoorRule(),
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+)
+
+func FrontDoorSecurityPolicyPatternToMatch(i interface{}, k string) (_ []string, errors []error) {
+	if m, regexErrs := validate.RegExHelper(i, k, `^/[^?#*]*\*?$`); !m {
+		return nil, append(regexErrs, fmt.Errorf(`%q must be a path beginning with a '/', which may only contain a wildcard ('*') as the final character and must not contain a query string or fragment, got %q`, k, i))
+	}
+
+	return nil, nil
+}
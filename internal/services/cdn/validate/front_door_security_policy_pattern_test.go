@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestFrontDoorSecurityPolicyPatternToMatch(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// all paths
+			Input: "/*",
+			Valid: true,
+		},
+
+		{
+			// root
+			Input: "/",
+			Valid: true,
+		},
+
+		{
+			// path prefix
+			Input: "/api/*",
+			Valid: true,
+		},
+
+		{
+			// exact path
+			Input: "/login/index.html",
+			Valid: true,
+		},
+
+		{
+			// missing leading slash
+			Input: "api/*",
+			Valid: false,
+		},
+
+		{
+			// wildcard not at the end
+			Input: "/api/*/users",
+			Valid: false,
+		},
+
+		{
+			// query string
+			Input: "/api?version=1",
+			Valid: false,
+		},
+
+		{
+			// fragment
+			Input: "/index.html#top",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := FrontDoorSecurityPolicyPatternToMatch(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
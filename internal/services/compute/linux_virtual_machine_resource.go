@@ -412,6 +412,10 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+			"last_resize_method": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 			"virtual_machine_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -1283,10 +1287,6 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 
 	if d.HasChange("size") {
 		shouldUpdate = true
-
-		// this is kind of superflurious since Azure can do this for us, but if we do this we can subsequently
-		// deallocate the VM to switch hosts if required
-		shouldShutDown = true
 		vmSize := d.Get("size").(string)
 
 		// Azure will auto-reboot this for us, providing this machine will fit on this host
@@ -1316,6 +1316,10 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 			// Message="Unable to resize the VM [name] because the requested size Standard_F4s_v2 is not available in the current hardware cluster.
 			//         The available sizes in this cluster are: [list]. The requested size might be available in other clusters of this region.
 			//         Read more on VM resizing strategy at https://aka.ms/azure-resizevm."
+			if !meta.(*clients.Client).Features.VirtualMachine.DeallocateOnResize {
+				return fmt.Errorf("the size %q is not available on the current host of Linux %s, resizing requires the Virtual Machine to be deallocated - this can be enabled using the `deallocate_on_resize` feature", vmSize, id)
+			}
+			shouldShutDown = true
 			shouldDeallocate = true
 		}
 
@@ -1481,7 +1485,8 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		shouldUpdate = true
 
 		n, _ := d.GetChange("additional_capabilities")
-		if len(n.([]interface{})) == 0 || d.HasChange("additional_capabilities.0.ultra_ssd_enabled") {
+		// Code="OperationNotAllowed" Message="Hibernation can only be enabled or disabled on a deallocated Virtual Machine."
+		if len(n.([]interface{})) == 0 || d.HasChange("additional_capabilities.0.ultra_ssd_enabled") || d.HasChange("additional_capabilities.0.hibernation_enabled") {
 			shouldShutDown = true
 			shouldDeallocate = true
 		}
@@ -1512,6 +1517,7 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		update.Properties.UserData = pointer.To(d.Get("user_data").(string))
 	}
 
+	alreadyDeallocated := false
 	if instanceView.Model.Statuses != nil {
 		for _, status := range *instanceView.Model.Statuses {
 			if status.Code == nil {
@@ -1528,6 +1534,7 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 			switch strings.ToLower(state) {
 			case "deallocated":
 				// VM already deallocated, no shutdown and deallocation needed anymore
+				alreadyDeallocated = true
 				shouldShutDown = false
 				shouldDeallocate = false
 			case "deallocating":
@@ -1542,6 +1549,16 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	// the resize is performed in-place (with Azure rebooting the VM) unless the VM is being deallocated, either to move
+	// to a host supporting the new size or due to another change requiring it
+	resizeMethod := ""
+	if d.HasChange("size") {
+		resizeMethod = virtualMachineResizeMethodInPlace
+		if shouldDeallocate || alreadyDeallocated {
+			resizeMethod = virtualMachineResizeMethodDeallocated
+		}
+	}
+
 	if shouldShutDown {
 		log.Printf("[DEBUG] Shutting Down Linux %s", id)
 
@@ -1634,6 +1651,11 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 
 		log.Printf("[DEBUG] Updated Linux %s", id)
+
+		// this is only set once the resize has succeeded
+		if resizeMethod != "" {
+			d.Set("last_resize_method", resizeMethod)
+		}
 	}
 
 	// if we've shut it down and it was turned off, let's boot it back up
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// managedDiskPerformanceTiers are the Premium SSD performance tiers in ascending order, along with the largest
+// disk size (in GB) which uses each tier as its baseline.
+// Source: https://learn.microsoft.com/en-us/azure/virtual-machines/disks-change-performance
+var managedDiskPerformanceTiers = []struct {
+	name      string
+	maxSizeGB int
+}{
+	{"P1", 4},
+	{"P2", 8},
+	{"P3", 16},
+	{"P4", 32},
+	{"P6", 64},
+	{"P10", 128},
+	{"P15", 256},
+	{"P20", 512},
+	{"P30", 1024},
+	{"P40", 2048},
+	{"P50", 4096},
+	{"P60", 8192},
+	{"P70", 16384},
+	{"P80", 32767},
+}
+
+func possibleValuesForManagedDiskPerformanceTier() []string {
+	out := make([]string, 0, len(managedDiskPerformanceTiers))
+	for _, v := range managedDiskPerformanceTiers {
+		out = append(out, v.name)
+	}
+	return out
+}
+
+// validateManagedDiskPerformanceTier ensures the performance tier isn't lower than the baseline tier for the size of
+// the disk, since a disk can only be moved to a tier equal to or higher than its baseline.
+func validateManagedDiskPerformanceTier(tier string, diskSizeGB int) error {
+	if tier == "" || diskSizeGB == 0 {
+		return nil
+	}
+
+	tierIndex := -1
+	baselineIndex := -1
+	for i, v := range managedDiskPerformanceTiers {
+		if strings.EqualFold(v.name, tier) {
+			tierIndex = i
+		}
+		if baselineIndex == -1 && diskSizeGB <= v.maxSizeGB {
+			baselineIndex = i
+		}
+	}
+
+	if tierIndex == -1 || baselineIndex == -1 {
+		return nil
+	}
+
+	if tierIndex < baselineIndex {
+		return fmt.Errorf("`tier` must be `%s` or higher for a `disk_size_gb` of %d", managedDiskPerformanceTiers[baselineIndex].name, diskSizeGB)
+	}
+
+	return nil
+}
+
+// validateManagedDiskPerformancePlus ensures Performance Plus is only enabled for the disk types and sizes which support it.
+// Source: https://learn.microsoft.com/en-us/azure/virtual-machines/disks-enable-performance
+func validateManagedDiskPerformancePlus(storageAccountType string, diskSizeGB int) error {
+	supported := false
+	for _, v := range []disks.DiskStorageAccountTypes{
+		disks.DiskStorageAccountTypesPremiumLRS,
+		disks.DiskStorageAccountTypesPremiumZRS,
+		disks.DiskStorageAccountTypesStandardSSDLRS,
+		disks.DiskStorageAccountTypesStandardSSDZRS,
+		disks.DiskStorageAccountTypesStandardLRS,
+	} {
+		if strings.EqualFold(storageAccountType, string(v)) {
+			supported = true
+		}
+	}
+	if !supported {
+		return fmt.Errorf("`performance_plus_enabled` can only be set to true when `storage_account_type` is set to `Premium_LRS`, `Premium_ZRS`, `StandardSSD_LRS`, `StandardSSD_ZRS` or `Standard_LRS`")
+	}
+
+	if diskSizeGB != 0 && diskSizeGB < 513 {
+		return fmt.Errorf("`performance_plus_enabled` can only be set to true when `disk_size_gb` is 513GB or larger")
+	}
+
+	return nil
+}
+
+// managedDiskPerformanceCustomizeDiff validates the `tier` and `performance_plus_enabled` against the type and size of
+// the disk at plan time, these are skipped when the values they depend on aren't known yet
+func managedDiskPerformanceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("storage_account_type") || !d.NewValueKnown("disk_size_gb") {
+		return nil
+	}
+
+	diskSizeGB := d.Get("disk_size_gb").(int)
+
+	if d.Get("performance_plus_enabled").(bool) {
+		if err := validateManagedDiskPerformancePlus(d.Get("storage_account_type").(string), diskSizeGB); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tier") && d.NewValueKnown("tier") {
+		if err := validateManagedDiskPerformanceTier(d.Get("tier").(string), diskSizeGB); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"testing"
+)
+
+func TestValidateManagedDiskPerformanceTier(t *testing.T) {
+	testData := []struct {
+		tier       string
+		diskSizeGB int
+		valid      bool
+	}{
+		{tier: "", diskSizeGB: 128, valid: true},
+		{tier: "P10", diskSizeGB: 0, valid: true},
+		{tier: "P10", diskSizeGB: 128, valid: true},
+		{tier: "P30", diskSizeGB: 128, valid: true},
+		{tier: "P6", diskSizeGB: 128, valid: false},
+		{tier: "P10", diskSizeGB: 129, valid: false},
+		{tier: "P15", diskSizeGB: 129, valid: true},
+		{tier: "P80", diskSizeGB: 32767, valid: true},
+		{tier: "P70", diskSizeGB: 32767, valid: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with a size of %d", v.tier, v.diskSizeGB)
+
+		err := validateManagedDiskPerformanceTier(v.tier, v.diskSizeGB)
+		if valid := err == nil; valid != v.valid {
+			t.Fatalf("expected valid to be %t but got %t: %+v", v.valid, valid, err)
+		}
+	}
+}
+
+func TestValidateManagedDiskPerformancePlus(t *testing.T) {
+	testData := []struct {
+		storageAccountType string
+		diskSizeGB         int
+		valid              bool
+	}{
+		{storageAccountType: "Premium_LRS", diskSizeGB: 513, valid: true},
+		{storageAccountType: "Premium_LRS", diskSizeGB: 512, valid: false},
+		{storageAccountType: "StandardSSD_ZRS", diskSizeGB: 1024, valid: true},
+		{storageAccountType: "Standard_LRS", diskSizeGB: 0, valid: true},
+		{storageAccountType: "PremiumV2_LRS", diskSizeGB: 1024, valid: false},
+		{storageAccountType: "UltraSSD_LRS", diskSizeGB: 1024, valid: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with a size of %d", v.storageAccountType, v.diskSizeGB)
+
+		err := validateManagedDiskPerformancePlus(v.storageAccountType, v.diskSizeGB)
+		if valid := err == nil; valid != v.valid {
+			t.Fatalf("expected valid to be %t but got %t: %+v", v.valid, valid, err)
+		}
+	}
+}
@@ -248,9 +248,10 @@ func resourceManagedDisk() *pluginsdk.Resource {
 			},
 
 			"tier": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForManagedDiskPerformanceTier(), false),
 			},
 
 			"max_shares": {
@@ -313,6 +314,7 @@ func resourceManagedDisk() *pluginsdk.Resource {
 				}
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			pluginsdk.CustomizeDiffShim(managedDiskPerformanceCustomizeDiff),
 		),
 	}
 }
@@ -659,8 +661,13 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		if storageAccountType != string(disks.DiskStorageAccountTypesPremiumZRS) && storageAccountType != string(disks.DiskStorageAccountTypesPremiumLRS) {
 			return fmt.Errorf("`tier` can only be specified when `storage_account_type` is set to `Premium_LRS` or `Premium_ZRS`")
 		}
-		shouldShutDown = true
 		tier := d.Get("tier").(string)
+
+		// the performance tier can be changed whilst the disk is attached, unless it's a shared disk
+		// https://learn.microsoft.com/en-us/azure/virtual-machines/disks-performance-tiers#restrictions
+		if disk.Model != nil && disk.Model.Properties != nil && pointer.From(disk.Model.Properties.MaxShares) > 1 {
+			shouldShutDown = true
+		}
 		diskUpdate.Properties.Tier = &tier
 	}
 
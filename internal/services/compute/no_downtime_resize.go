@@ -30,13 +30,13 @@ func determineIfDataDiskSupportsNoDowntimeResize(disk *disks.Disk, oldSizeGb, ne
 	}
 
 	// Only supported for data disks.
-	isDataDisk := disk.Properties.OsType != nil && string(*disk.Properties.OsType) != ""
-	if isDataDisk {
+	isOsDisk := disk.Properties.OsType != nil && string(*disk.Properties.OsType) != ""
+	if isOsDisk {
 		return pointer.To(false)
 	}
 
-	// Not supported for shared disks.
-	isSharedDisk := disk.Properties.MaxShares != nil && *disk.Properties.MaxShares >= 0
+	// Not supported for shared disks - the API returns `maxShares` as `1` for disks which aren't shared.
+	isSharedDisk := disk.Properties.MaxShares != nil && *disk.Properties.MaxShares > 1
 	if isSharedDisk {
 		log.Printf("[DEBUG] Disk is shared so does not support no-downtime-resize")
 		return pointer.To(false)
@@ -99,6 +99,10 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 			// identical for both uniform and flex mode VMSS's
 			"automatic_instance_repair": VirtualMachineScaleSetAutomaticRepairsPolicySchema(),
 
+			// NOTE: the upgrade policy is now supported in flex mode VMSS's so these
+			// share the schema with the uniform mode VMSS's
+			"automatic_os_upgrade_policy": VirtualMachineScaleSetAutomatedOSUpgradePolicySchema(),
+
 			"boot_diagnostics": bootDiagnosticsSchema(),
 
 			"capacity_reservation_group_id": {
@@ -213,6 +217,8 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 				},
 			},
 
+			"rolling_upgrade_policy": VirtualMachineScaleSetRollingUpgradePolicySchema(),
+
 			// NOTE: single_placement_group is now supported in orchestrated VMSS
 			// Since null is now a valid value for this field there is no default
 			// for this bool
@@ -254,6 +260,18 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"upgrade_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(virtualmachinescalesets.UpgradeModeManual),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(virtualmachinescalesets.UpgradeModeAutomatic),
+					string(virtualmachinescalesets.UpgradeModeManual),
+					string(virtualmachinescalesets.UpgradeModeRolling),
+				}, false),
+			},
+
 			// Computed
 			"unique_id": {
 				Type:     pluginsdk.TypeString,
@@ -337,9 +355,29 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	// Not currently supported in OVMSS
-	// healthProbeId := d.Get("health_probe_id").(string)
-	// upgradeMode := virtualmachinescalesets.UpgradeMode(d.Get("upgrade_mode").(string))
+	upgradeMode := virtualmachinescalesets.UpgradeMode(d.Get("upgrade_mode").(string))
+	automaticOSUpgradePolicyRaw := d.Get("automatic_os_upgrade_policy").([]interface{})
+	rollingUpgradePolicyRaw := d.Get("rolling_upgrade_policy").([]interface{})
+
+	canHaveAutomaticOsUpgradePolicy := upgradeMode == virtualmachinescalesets.UpgradeModeAutomatic || upgradeMode == virtualmachinescalesets.UpgradeModeRolling
+	if !canHaveAutomaticOsUpgradePolicy && len(automaticOSUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("an `automatic_os_upgrade_policy` block cannot be specified when `upgrade_mode` is not set to `Automatic` or `Rolling`")
+	}
+
+	shouldHaveRollingUpgradePolicy := upgradeMode == virtualmachinescalesets.UpgradeModeAutomatic || upgradeMode == virtualmachinescalesets.UpgradeModeRolling
+	if !shouldHaveRollingUpgradePolicy && len(rollingUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("a `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+	shouldHaveRollingUpgradePolicy = upgradeMode == virtualmachinescalesets.UpgradeModeRolling
+	if shouldHaveRollingUpgradePolicy && len(rollingUpgradePolicyRaw) == 0 {
+		return fmt.Errorf("a `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
+	// overprovisioning isn't supported in flex mode so `maximum_surge_instances_enabled` can always be specified
+	rollingUpgradePolicy, err := ExpandVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicyRaw, len(zones) > 0, false)
+	if err != nil {
+		return err
+	}
 
 	instances := d.Get("instances").(int)
 	if v, ok := d.GetOk("sku_name"); ok {
@@ -363,12 +401,9 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	// hasHealthExtension is currently not needed but I added the plumming because we will need it
-	// once upgrade policy is added to OVMSS
 	hasHealthExtension := false
 
 	if v, ok := d.GetOk("extension"); ok {
-		var err error
 		virtualMachineProfile.ExtensionProfile, hasHealthExtension, err = expandOrchestratedVirtualMachineScaleSetExtensions(v.(*pluginsdk.Set).List())
 		if err != nil {
 			return err
@@ -379,6 +414,12 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		log.Printf("[DEBUG] Orchestrated %s has a Health Extension defined", id)
 	}
 
+	// otherwise the service return the error:
+	// Rolling Upgrade mode is not supported for this Virtual Machine Scale Set because a health probe or health extension was not provided.
+	if upgradeMode == virtualmachinescalesets.UpgradeModeRolling && !hasHealthExtension {
+		return fmt.Errorf("a health extension must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
 	if v, ok := d.GetOk("extensions_time_budget"); ok {
 		if virtualMachineProfile.ExtensionProfile == nil {
 			virtualMachineProfile.ExtensionProfile = &virtualmachinescalesets.VirtualMachineScaleSetExtensionProfile{}
@@ -622,6 +663,12 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 			props.Properties.AutomaticRepairsPolicy = ExpandVirtualMachineScaleSetAutomaticRepairsPolicy(v.([]interface{}))
 		}
 
+		props.Properties.UpgradePolicy = &virtualmachinescalesets.UpgradePolicy{
+			Mode:                     pointer.To(upgradeMode),
+			AutomaticOSUpgradePolicy: ExpandVirtualMachineScaleSetAutomaticUpgradePolicy(automaticOSUpgradePolicyRaw),
+			RollingUpgradePolicy:     rollingUpgradePolicy,
+		}
+
 		if v, ok := d.GetOk("zone_balance"); ok && v.(bool) {
 			if props.Zones == nil || len(*props.Zones) == 0 {
 				return fmt.Errorf("`zone_balance` can only be set to `true` when zones are specified")
@@ -665,6 +712,7 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 
 	isLegacy := true
 	updateInstances := false
+	automaticOSUpgradeIsEnabled := false
 	isHotpatchEnabledImage := false
 	linuxAutomaticVMGuestPatchingEnabled := false
 
@@ -707,10 +755,49 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 					ImageReference: existing.Model.Properties.VirtualMachineProfile.StorageProfile.ImageReference,
 				},
 			},
-			// Currently not suppored in orchestrated VMSS
 			// if an upgrade policy's been configured previously (which it will have) it must be threaded through
 			// this doesn't matter for Manual - but breaks when updating anything on a Automatic and Rolling Mode Scale Set
-			// UpgradePolicy: existing.Properties.UpgradePolicy,
+			UpgradePolicy: existing.Model.Properties.UpgradePolicy,
+		}
+
+		// first try and pull this from existing vm, which covers no changes being made to this block
+		if policy := existing.Model.Properties.UpgradePolicy; policy != nil {
+			if policy.AutomaticOSUpgradePolicy != nil && policy.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade != nil {
+				automaticOSUpgradeIsEnabled = *policy.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade
+			}
+		}
+
+		if d.HasChanges("automatic_os_upgrade_policy", "rolling_upgrade_policy") {
+			upgradeMode := virtualmachinescalesets.UpgradeMode(d.Get("upgrade_mode").(string))
+			upgradePolicy := virtualmachinescalesets.UpgradePolicy{}
+			if existing.Model.Properties.UpgradePolicy != nil {
+				upgradePolicy = *existing.Model.Properties.UpgradePolicy
+			}
+			upgradePolicy.Mode = pointer.To(upgradeMode)
+
+			if d.HasChange("automatic_os_upgrade_policy") {
+				automaticRaw := d.Get("automatic_os_upgrade_policy").([]interface{})
+				if upgradeMode == virtualmachinescalesets.UpgradeModeManual && len(automaticRaw) > 0 {
+					return fmt.Errorf("an `automatic_os_upgrade_policy` block cannot be specified when `upgrade_mode` is not set to `Automatic` or `Rolling`")
+				}
+				upgradePolicy.AutomaticOSUpgradePolicy = ExpandVirtualMachineScaleSetAutomaticUpgradePolicy(automaticRaw)
+
+				if upgradePolicy.AutomaticOSUpgradePolicy != nil {
+					automaticOSUpgradeIsEnabled = *upgradePolicy.AutomaticOSUpgradePolicy.EnableAutomaticOSUpgrade
+				}
+			}
+
+			if d.HasChange("rolling_upgrade_policy") {
+				rollingRaw := d.Get("rolling_upgrade_policy").([]interface{})
+				zones := zones.ExpandUntyped(d.Get("zones").(*schema.Set).List())
+				rollingUpgradePolicy, err := ExpandVirtualMachineScaleSetRollingUpgradePolicy(rollingRaw, len(zones) > 0, false)
+				if err != nil {
+					return err
+				}
+				upgradePolicy.RollingUpgradePolicy = rollingUpgradePolicy
+			}
+
+			updateProps.UpgradePolicy = &upgradePolicy
 		}
 
 		priority := virtualmachinescalesets.VirtualMachinePriorityTypes(d.Get("priority").(string))
@@ -1076,9 +1163,8 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 		log.Printf("[DEBUG] Orchestrated %s - updateInstances is true", id)
 	}
 
-	// AutomaticOSUpgradeIsEnabled currently is not supported in orchestrated VMSS flex
 	metaData := virtualMachineScaleSetUpdateMetaData{
-		AutomaticOSUpgradeIsEnabled:  false,
+		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanReimageOnManualUpgrade:    false,
 		CanRollInstancesWhenRequired: false,
 		UpdateInstances:              false,
@@ -1176,6 +1262,25 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 			d.Set("unique_id", props.UniqueId)
 			d.Set("zone_balance", props.ZoneBalance)
 
+			// existing Scale Sets created before the upgrade policy was supported in flex mode may not return one
+			upgradeMode := string(virtualmachinescalesets.UpgradeModeManual)
+			automaticOSUpgradePolicy := make([]interface{}, 0)
+			rollingUpgradePolicy := make([]interface{}, 0)
+			if policy := props.UpgradePolicy; policy != nil {
+				if policy.Mode != nil {
+					upgradeMode = string(*policy.Mode)
+				}
+				automaticOSUpgradePolicy = FlattenVirtualMachineScaleSetAutomaticOSUpgradePolicy(policy.AutomaticOSUpgradePolicy)
+				rollingUpgradePolicy = FlattenVirtualMachineScaleSetRollingUpgradePolicy(policy.RollingUpgradePolicy)
+			}
+			d.Set("upgrade_mode", upgradeMode)
+			if err := d.Set("automatic_os_upgrade_policy", automaticOSUpgradePolicy); err != nil {
+				return fmt.Errorf("setting `automatic_os_upgrade_policy`: %+v", err)
+			}
+			if err := d.Set("rolling_upgrade_policy", rollingUpgradePolicy); err != nil {
+				return fmt.Errorf("setting `rolling_upgrade_policy`: %+v", err)
+			}
+
 			extensionOperationsEnabled := true
 			if profile := props.VirtualMachineProfile; profile != nil {
 				if err := d.Set("boot_diagnostics", flattenBootDiagnosticsVMSS(profile.DiagnosticsProfile)); err != nil {
@@ -20,6 +20,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+const (
+	virtualMachineResizeMethodInPlace     = "InPlace"
+	virtualMachineResizeMethodDeallocated = "Deallocated"
+)
+
 func virtualMachineAdditionalCapabilitiesSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -429,6 +429,10 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+			"last_resize_method": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 			"virtual_machine_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -1454,10 +1458,6 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 
 	if d.HasChange("size") {
 		shouldUpdate = true
-
-		// this is kind of superflurious since Azure can do this for us, but if we do this we can subsequently
-		// deallocate the VM to switch hosts if required
-		shouldShutDown = true
 		vmSize := d.Get("size").(string)
 
 		// Azure will auto-reboot this for us, providing this machine will fit on this host
@@ -1487,6 +1487,10 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 			// Message="Unable to resize the VM [name] because the requested size Standard_F4s_v2 is not available in the current hardware cluster.
 			//         The available sizes in this cluster are: [list]. The requested size might be available in other clusters of this region.
 			//         Read more on VM resizing strategy at https://aka.ms/azure-resizevm."
+			if !meta.(*clients.Client).Features.VirtualMachine.DeallocateOnResize {
+				return fmt.Errorf("the size %q is not available on the current host of Windows %s, resizing requires the Virtual Machine to be deallocated - this can be enabled using the `deallocate_on_resize` feature", vmSize, id)
+			}
+			shouldShutDown = true
 			shouldDeallocate = true
 		}
 
@@ -1526,7 +1530,8 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		shouldUpdate = true
 
 		n, _ := d.GetChange("additional_capabilities")
-		if len(n.([]interface{})) == 0 || d.HasChange("additional_capabilities.0.ultra_ssd_enabled") {
+		// Code="OperationNotAllowed" Message="Hibernation can only be enabled or disabled on a deallocated Virtual Machine."
+		if len(n.([]interface{})) == 0 || d.HasChange("additional_capabilities.0.ultra_ssd_enabled") || d.HasChange("additional_capabilities.0.hibernation_enabled") {
 			shouldShutDown = true
 			shouldDeallocate = true
 		}
@@ -1570,6 +1575,7 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		update.Properties.UserData = pointer.To(d.Get("user_data").(string))
 	}
 
+	alreadyDeallocated := false
 	if instanceView.Model != nil && instanceView.Model.Statuses != nil {
 		for _, status := range *instanceView.Model.Statuses {
 			if status.Code == nil {
@@ -1586,6 +1592,7 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 			switch strings.ToLower(state) {
 			case "deallocated":
 				// VM already deallocated, no shutdown and deallocation needed anymore
+				alreadyDeallocated = true
 				shouldShutDown = false
 				shouldDeallocate = false
 			case "deallocating":
@@ -1600,6 +1607,16 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	// the resize is performed in-place (with Azure rebooting the VM) unless the VM is being deallocated, either to move
+	// to a host supporting the new size or due to another change requiring it
+	resizeMethod := ""
+	if d.HasChange("size") {
+		resizeMethod = virtualMachineResizeMethodInPlace
+		if shouldDeallocate || alreadyDeallocated {
+			resizeMethod = virtualMachineResizeMethodDeallocated
+		}
+	}
+
 	if shouldShutDown {
 		log.Printf("[DEBUG] Shutting Down Windows %s", id)
 		if err := client.PowerOffThenPoll(ctx, *id, virtualmachines.DefaultPowerOffOperationOptions()); err != nil {
@@ -1690,6 +1707,11 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 
 		log.Printf("[DEBUG] Updated Windows %s.", id)
+
+		// this is only set once the resize has succeeded
+		if resizeMethod != "" {
+			d.Set("last_resize_method", resizeMethod)
+		}
 	}
 
 	// if we've shut it down and it was turned off, let's boot it back up
@@ -272,14 +272,15 @@ func TestAccWindowsVirtualMachine_scalingMachineSizeUpdate(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("admin_password"),
+		data.ImportStep("admin_password", "last_resize_method"),
 		{
 			Config: r.scalingMachineSize(data, "Standard_F4s_v2"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("last_resize_method").Exists(),
 			),
 		},
-		data.ImportStep("admin_password"),
+		data.ImportStep("admin_password", "last_resize_method"),
 	})
 }
 
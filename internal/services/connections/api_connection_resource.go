@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/connections/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -72,9 +73,21 @@ func resourceConnection() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
-			"parameter_values": {
-				Type:     pluginsdk.TypeMap,
+			"kind": {
+				Type:     pluginsdk.TypeString,
 				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"V1",
+					"V2",
+				}, false),
+			},
+
+			"parameter_values": {
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter_value_set"},
 				// @tombuildsstuff: this can't be patched in API version 2016-06-01 and there isn't Swagger for
 				// API version 2018-07-01-preview, so I guess this is ForceNew for now
 				//
@@ -87,7 +100,39 @@ func resourceConnection() *pluginsdk.Resource {
 				},
 			},
 
+			"parameter_value_set": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"parameter_values"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"values": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
+
+			"connection_runtime_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -114,23 +159,33 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("parsing `managed_app_id`: %+v", err)
 	}
 	location := location.Normalize(managedAppId.LocationName)
-	parameterValues := expandConnectionParameterValues(d.Get("parameter_values").(map[string]interface{}))
-	model := connections.ApiConnectionDefinition{
+	model := azuresdkhacks.ApiConnectionDefinition{
 		Location: utils.String(location),
-		Properties: &connections.ApiConnectionDefinitionProperties{
-			Api: &connections.ApiReference{
-				Id: utils.String(managedAppId.ID()),
+		Properties: &azuresdkhacks.ApiConnectionDefinitionProperties{
+			ApiConnectionDefinitionProperties: connections.ApiConnectionDefinitionProperties{
+				Api: &connections.ApiReference{
+					Id: utils.String(managedAppId.ID()),
+				},
+				DisplayName: utils.String(d.Get("display_name").(string)),
 			},
-			DisplayName:     utils.String(d.Get("display_name").(string)),
-			ParameterValues: parameterValues,
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 	if v := d.Get("display_name").(string); v != "" {
 		model.Properties.DisplayName = utils.String(v)
 	}
+	if v := d.Get("kind").(string); v != "" {
+		model.Kind = utils.String(v)
+	}
+
+	// the API rejects `parameterValues` and `parameterValueSet` being specified together
+	if v := d.Get("parameter_value_set").([]interface{}); len(v) > 0 {
+		model.Properties.ParameterValueSet = expandConnectionParameterValueSet(v)
+	} else {
+		model.Properties.ParameterValues = expandConnectionParameterValues(d.Get("parameter_values").(map[string]interface{}))
+	}
 
-	if _, err := client.CreateOrUpdate(ctx, id, model); err != nil {
+	if _, err := azuresdkhacks.NewConnectionsClient(client).CreateOrUpdate(ctx, id, model); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -148,7 +203,7 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id)
+	resp, err := azuresdkhacks.NewConnectionsClient(client).Get(ctx, *id)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state", *id)
@@ -174,8 +229,16 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("parameter_values", parameterValues); err != nil {
 				return fmt.Errorf("setting `parameter_values`: %+v", err)
 			}
+
+			if err := d.Set("parameter_value_set", flattenConnectionParameterValueSet(props.ParameterValueSet, d.Get("parameter_value_set").([]interface{}))); err != nil {
+				return fmt.Errorf("setting `parameter_value_set`: %+v", err)
+			}
+
+			d.Set("connection_runtime_url", props.ConnectionRuntimeUrl)
 		}
 
+		d.Set("kind", model.Kind)
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
@@ -245,3 +308,48 @@ func flattenConnectionParameterValues(input *map[string]string) map[string]inter
 	}
 	return parameterValues
 }
+
+func expandConnectionParameterValueSet(input []interface{}) *azuresdkhacks.ParameterValueSet {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	values := make(map[string]azuresdkhacks.ParameterValueSetValue)
+	for k, v := range raw["values"].(map[string]interface{}) {
+		values[k] = azuresdkhacks.ParameterValueSetValue{
+			Value: v.(string),
+		}
+	}
+
+	return &azuresdkhacks.ParameterValueSet{
+		Name:   raw["name"].(string),
+		Values: values,
+	}
+}
+
+func flattenConnectionParameterValueSet(input *azuresdkhacks.ParameterValueSet, existing []interface{}) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	// secret values aren't returned by the API, so they're retained from the state
+	values := make(map[string]interface{})
+	if len(existing) > 0 && existing[0] != nil {
+		if v, ok := existing[0].(map[string]interface{})["values"].(map[string]interface{}); ok {
+			values = v
+		}
+	}
+	for k, v := range input.Values {
+		if v.Value != "" {
+			values[k] = v.Value
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":   input.Name,
+			"values": values,
+		},
+	}
+}
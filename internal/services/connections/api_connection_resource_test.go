@@ -70,6 +70,22 @@ func TestAccApiConnection_complete(t *testing.T) {
 	})
 }
 
+func TestAccApiConnection_managedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_connection", "test")
+	r := ApiConnectionTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("V2"),
+				check.That(data.ResourceName).Key("connection_runtime_url").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ApiConnectionTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := connections.ParseConnectionID(state.ID)
 	if err != nil {
@@ -179,6 +195,31 @@ resource "azurerm_api_connection" "test" {
 `, template, data.RandomInteger)
 }
 
+func (t ApiConnectionTestResource) managedIdentity(data acceptance.TestData) string {
+	template := t.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_api_connection" "test" {
+  name                = "acctestconn-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  managed_api_id      = data.azurerm_managed_api.test.id
+  kind                = "V2"
+
+  parameter_value_set {
+    name = "ManagedServiceIdentity"
+    values = {
+      namespaceEndpoint = "sb://${azurerm_servicebus_namespace.test.name}.servicebus.windows.net/"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (ApiConnectionTestResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
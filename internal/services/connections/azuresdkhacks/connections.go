@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/connections"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TODO: remove once the Swagger defines these fields
+// the Swagger for API version 2016-06-01 lacks the `kind`, `parameterValueSet` and `connectionRuntimeUrl` fields, which
+// are accepted by the API and are required to use Managed Identity authentication with an API Connection.
+
+type ApiConnectionDefinition struct {
+	Etag       *string                            `json:"etag,omitempty"`
+	Id         *string                            `json:"id,omitempty"`
+	Kind       *string                            `json:"kind,omitempty"`
+	Location   *string                            `json:"location,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *ApiConnectionDefinitionProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
+
+type ApiConnectionDefinitionProperties struct {
+	connections.ApiConnectionDefinitionProperties
+
+	ConnectionRuntimeUrl *string            `json:"connectionRuntimeUrl,omitempty"`
+	ParameterValueSet    *ParameterValueSet `json:"parameterValueSet,omitempty"`
+}
+
+type ParameterValueSet struct {
+	Name   string                            `json:"name"`
+	Values map[string]ParameterValueSetValue `json:"values"`
+}
+
+type ParameterValueSetValue struct {
+	Value string `json:"value"`
+}
+
+type ConnectionsClient struct {
+	client *connections.ConnectionsClient
+}
+
+func NewConnectionsClient(client *connections.ConnectionsClient) ConnectionsClient {
+	return ConnectionsClient{
+		client: client,
+	}
+}
+
+type CreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ApiConnectionDefinition
+}
+
+func (c ConnectionsClient) CreateOrUpdate(ctx context.Context, id connections.ConnectionId, input ApiConnectionDefinition) (result CreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model ApiConnectionDefinition
+	result.Model = &model
+
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type GetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ApiConnectionDefinition
+}
+
+func (c ConnectionsClient) Get(ctx context.Context, id connections.ConnectionId) (result GetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model ApiConnectionDefinition
+	result.Model = &model
+
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
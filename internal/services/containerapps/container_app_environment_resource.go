@@ -412,7 +412,18 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("workload_profile") {
-				existing.Model.Properties.WorkloadProfiles = helpers.ExpandWorkloadProfiles(state.WorkloadProfiles)
+				workloadProfiles := helpers.ExpandWorkloadProfiles(state.WorkloadProfiles)
+				if workloadProfiles == nil {
+					// an Environment which has Workload Profiles must always keep at least one, so when all the
+					// profiles are removed from the config we fall back to the (free) Consumption profile in place
+					workloadProfiles = &[]managedenvironments.WorkloadProfile{
+						{
+							Name:                string(helpers.WorkloadProfileSkuConsumption),
+							WorkloadProfileType: string(helpers.WorkloadProfileSkuConsumption),
+						},
+					}
+				}
+				existing.Model.Properties.WorkloadProfiles = workloadProfiles
 			}
 
 			if metadata.ResourceData.HasChange("mutual_tls_enabled") {
@@ -483,7 +494,7 @@ func consumptionIsExplicitlyDefined(metadata sdk.ResourceMetaData) bool {
 
 func (r ContainerAppEnvironmentResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
-		Timeout: 5,
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			if metadata.ResourceDiff == nil {
 				return nil
@@ -494,20 +505,38 @@ func (r ContainerAppEnvironmentResource) CustomizeDiff() sdk.ResourceFunc {
 				return err
 			}
 
+			for i, profile := range env.WorkloadProfiles {
+				if profile.Name == string(helpers.WorkloadProfileSkuConsumption) && profile.WorkloadProfileType != string(helpers.WorkloadProfileSkuConsumption) {
+					return fmt.Errorf("`workload_profile.%d.workload_profile_type` must be `%s` when `name` is `%s`", i, helpers.WorkloadProfileSkuConsumption, helpers.WorkloadProfileSkuConsumption)
+				}
+				if helpers.IsConsumptionWorkloadProfileSku(profile.WorkloadProfileType) && (profile.MinimumCount != 0 || profile.MaximumCount != 0) {
+					return fmt.Errorf("`minimum_count` and `maximum_count` cannot be specified for the `workload_profile` %q since the type %q is billed per use", profile.Name, profile.WorkloadProfileType)
+				}
+			}
+
 			if metadata.ResourceDiff.HasChange("workload_profile") {
 				oldProfiles, newProfiles := metadata.ResourceDiff.GetChange("workload_profile")
 
+				// Workload Profiles can be added, removed and resized in place on an Environment which was created with them,
+				// however an Environment created without Workload Profiles (i.e. Consumption only) cannot have them added later
 				oldProfileCount := oldProfiles.(*pluginsdk.Set).Len()
 				newProfileCount := newProfiles.(*pluginsdk.Set).Len()
-				if oldProfileCount > 0 && newProfileCount == 0 {
-					if err := metadata.ResourceDiff.ForceNew("workload_profile"); err != nil {
+				if newProfileCount > 0 && oldProfileCount == 0 && metadata.ResourceDiff.Id() != "" {
+					id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceDiff.Id())
+					if err != nil {
 						return err
 					}
-				}
 
-				if newProfileCount > 0 && oldProfileCount == 0 {
-					if err := metadata.ResourceDiff.ForceNew("workload_profile"); err != nil {
-						return err
+					// the implicit Consumption profile isn't present in the state, so check whether the Environment supports Workload Profiles
+					existing, err := metadata.Client.ContainerApps.ManagedEnvironmentClient.Get(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("retrieving %s: %+v", *id, err)
+					}
+
+					if existing.Model == nil || existing.Model.Properties == nil || len(pointer.From(existing.Model.Properties.WorkloadProfiles)) == 0 {
+						if err := metadata.ResourceDiff.ForceNew("workload_profile"); err != nil {
+							return err
+						}
 					}
 				}
 			}
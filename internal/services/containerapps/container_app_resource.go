@@ -6,7 +6,9 @@ package containerapps
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerappsrevisions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
@@ -39,6 +42,8 @@ type ContainerAppModel struct {
 	Dapr         []helpers.Dapr              `tfschema:"dapr"`
 	Template     []helpers.ContainerTemplate `tfschema:"template"`
 
+	UnusedRevisionsDeactivationEnabled bool `tfschema:"unused_revisions_deactivation_enabled"`
+
 	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
 	WorkloadProfileName string                                     `tfschema:"workload_profile_name"`
 	Tags                map[string]interface{}                     `tfschema:"tags"`
@@ -96,6 +101,13 @@ func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
 			}, false),
 		},
 
+		"unused_revisions_deactivation_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should active Revisions which no longer receive any traffic be deactivated? Only applicable when `revision_mode` is `Multiple`.",
+		},
+
 		"ingress": helpers.ContainerAppIngressSchema(),
 
 		"registry": helpers.ContainerAppRegistrySchema(),
@@ -253,6 +265,8 @@ func (r ContainerAppResource) Read() sdk.ResourceFunc {
 
 			state.Name = id.ContainerAppName
 			state.ResourceGroup = id.ResourceGroupName
+			// this isn't returned by the API, so we pull it from the config/state
+			state.UnusedRevisionsDeactivationEnabled = metadata.ResourceData.Get("unused_revisions_deactivation_enabled").(bool)
 
 			if model := existing.Model; model != nil {
 				state.Location = location.Normalize(model.Location)
@@ -422,6 +436,12 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if state.UnusedRevisionsDeactivationEnabled && state.RevisionMode == string(containerapps.ActiveRevisionsModeMultiple) {
+				if err := deactivateUnusedContainerAppRevisions(ctx, client, metadata.Client.ContainerApps.ContainerAppRevisionClient, *id); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -471,6 +491,15 @@ func (r ContainerAppResource) CustomizeDiff() sdk.ResourceFunc {
 					if latestRevCount > 1 {
 						return fmt.Errorf("more than one `ingress.0.traffic_weight` has `latest_revision` set to `true`")
 					}
+
+					// in Single mode only the latest Revision is active, so it must receive all of the traffic
+					if app.RevisionMode == string(containerapps.ActiveRevisionsModeSingle) {
+						for i, tw := range ingress.TrafficWeights {
+							if !tw.LatestRevision && tw.Weight > 0 {
+								return fmt.Errorf("`ingress.0.traffic_weight.%d` can only send traffic to a specific Revision when `revision_mode` is set to `%s`", i, string(containerapps.ActiveRevisionsModeMultiple))
+							}
+						}
+					}
 				}
 			}
 
@@ -486,3 +515,42 @@ func (r ContainerAppResource) CustomizeDiff() sdk.ResourceFunc {
 		},
 	}
 }
+
+// deactivateUnusedContainerAppRevisions deactivates the active Revisions of a Container App which aren't receiving any
+// traffic - the latest Revision is always left active since it may not be receiving traffic whilst it's being provisioned
+func deactivateUnusedContainerAppRevisions(ctx context.Context, appClient *containerapps.ContainerAppsClient, client *containerappsrevisions.ContainerAppsRevisionsClient, id containerapps.ContainerAppId) error {
+	app, err := appClient.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	latestRevisionName := ""
+	if app.Model != nil && app.Model.Properties != nil {
+		latestRevisionName = pointer.From(app.Model.Properties.LatestRevisionName)
+	}
+
+	appId := containerappsrevisions.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+	revisions, err := client.ListRevisionsComplete(ctx, appId, containerappsrevisions.DefaultListRevisionsOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Revisions for %s: %+v", id, err)
+	}
+
+	for _, revision := range revisions.Items {
+		name := pointer.From(revision.Name)
+		props := revision.Properties
+		if name == "" || strings.EqualFold(name, latestRevisionName) || props == nil {
+			continue
+		}
+
+		if !pointer.From(props.Active) || pointer.From(props.TrafficWeight) > 0 {
+			continue
+		}
+
+		revisionId := containerappsrevisions.NewRevisionID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName, name)
+		log.Printf("[DEBUG] Deactivating %s since it is not receiving any traffic", revisionId)
+		if _, err := client.DeactivateRevision(ctx, revisionId); err != nil {
+			return fmt.Errorf("deactivating %s: %+v", revisionId, err)
+		}
+	}
+
+	return nil
+}
@@ -374,6 +374,48 @@ func TestAccContainerAppResource_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppResource_unusedRevisionsDeactivation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app", "test")
+	r := ContainerAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unusedRevisionsDeactivation(data, "rev1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("unused_revisions_deactivation_enabled"),
+		{
+			Config: r.unusedRevisionsDeactivation(data, "rev2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("unused_revisions_deactivation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("unused_revisions_deactivation_enabled"),
+	})
+}
+
+func TestAccContainerAppResource_ingressTrafficSingleRevisionModeValidation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app", "test")
+	r := ContainerAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "rev1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.ingressTrafficSingleRevisionMode(data, "rev2"),
+			ExpectError: regexp.MustCompile("can only send traffic to a specific Revision when `revision_mode` is set to `Multiple`"),
+		},
+	})
+}
+
 func TestAccContainerAppResource_completeTcpExposedPort(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_app", "test")
 	r := ContainerAppResource{}
@@ -623,6 +665,81 @@ resource "azurerm_container_app" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ContainerAppResource) unusedRevisionsDeactivation(data acceptance.TestData, revisionSuffix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Multiple"
+
+  unused_revisions_deactivation_enabled = true
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+
+    revision_suffix = "%[3]s"
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, revisionSuffix)
+}
+
+func (r ContainerAppResource) ingressTrafficSingleRevisionMode(data acceptance.TestData, revisionSuffix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+
+    revision_suffix = "%[3]s"
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 50
+    }
+
+    traffic_weight {
+      revision_suffix = "rev1"
+      percentage      = 50
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, revisionSuffix)
+}
+
 func (r ContainerAppResource) basicWithRetainedSecret(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
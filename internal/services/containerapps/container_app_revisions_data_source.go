@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerappsrevisions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppRevisionsDataSource struct{}
+
+type ContainerAppRevisionsDataSourceModel struct {
+	ContainerAppId string `tfschema:"container_app_id"`
+	NameRegex      string `tfschema:"name_regex"`
+	ActiveOnly     bool   `tfschema:"active_only"`
+
+	Revisions []ContainerAppRevisionModel `tfschema:"revisions"`
+}
+
+type ContainerAppRevisionModel struct {
+	Name              string `tfschema:"name"`
+	RevisionSuffix    string `tfschema:"revision_suffix"`
+	Active            bool   `tfschema:"active"`
+	CreatedTime       string `tfschema:"created_time"`
+	Fqdn              string `tfschema:"fqdn"`
+	HealthState       string `tfschema:"health_state"`
+	ProvisioningState string `tfschema:"provisioning_state"`
+	RunningState      string `tfschema:"running_state"`
+	Replicas          int64  `tfschema:"replicas"`
+	TrafficWeight     int64  `tfschema:"traffic_weight"`
+}
+
+var _ sdk.DataSource = ContainerAppRevisionsDataSource{}
+
+func (r ContainerAppRevisionsDataSource) ModelObject() interface{} {
+	return &ContainerAppRevisionsDataSourceModel{}
+}
+
+func (r ContainerAppRevisionsDataSource) ResourceType() string {
+	return "azurerm_container_app_revisions"
+}
+
+func (r ContainerAppRevisionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: containerappsrevisions.ValidateContainerAppID,
+			Description:  "The ID of the Container App to list the Revisions of.",
+		},
+
+		"name_regex": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			Description:  "A regular expression used to filter the Revisions by name.",
+		},
+
+		"active_only": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should only the active Revisions be returned?",
+		},
+	}
+}
+
+func (r ContainerAppRevisionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"revisions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+						Description: "The name of the Revision.",
+					},
+
+					"revision_suffix": {
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+						Description: "The suffix of the Revision, which can be used in the `traffic_weight` blocks of the Container App.",
+					},
+
+					"active": {
+						Type:        pluginsdk.TypeBool,
+						Computed:    true,
+						Description: "Is the Revision active?",
+					},
+
+					"created_time": {
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+						Description: "The time at which the Revision was created.",
+					},
+
+					"fqdn": {
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+						Description: "The FQDN of the Revision.",
+					},
+
+					"health_state": {
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+						Description: "The health state of the Revision.",
+					},
+
+					"provisioning_state": {
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+						Description: "The provisioning state of the Revision.",
+					},
+
+					"running_state": {
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+						Description: "The running state of the Revision.",
+					},
+
+					"replicas": {
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+						Description: "The number of replicas of the Revision.",
+					},
+
+					"traffic_weight": {
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+						Description: "The percentage of traffic sent to the Revision.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppRevisionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppRevisionClient
+
+			var state ContainerAppRevisionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			id, err := containerappsrevisions.ParseContainerAppID(state.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			var nameRegex *regexp.Regexp
+			if state.NameRegex != "" {
+				nameRegex, err = regexp.Compile(state.NameRegex)
+				if err != nil {
+					return fmt.Errorf("compiling `name_regex`: %+v", err)
+				}
+			}
+
+			resp, err := client.ListRevisionsComplete(ctx, *id, containerappsrevisions.DefaultListRevisionsOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Revisions for %s: %+v", *id, err)
+			}
+
+			prefix := fmt.Sprintf("%s--", id.ContainerAppName)
+			revisions := make([]ContainerAppRevisionModel, 0)
+			for _, item := range resp.Items {
+				name := pointer.From(item.Name)
+				if nameRegex != nil && !nameRegex.MatchString(name) {
+					continue
+				}
+
+				revision := ContainerAppRevisionModel{
+					Name:           name,
+					RevisionSuffix: strings.TrimPrefix(name, prefix),
+				}
+
+				if props := item.Properties; props != nil {
+					revision.Active = pointer.From(props.Active)
+					revision.CreatedTime = pointer.From(props.CreatedTime)
+					revision.Fqdn = pointer.From(props.Fqdn)
+					revision.HealthState = string(pointer.From(props.HealthState))
+					revision.ProvisioningState = string(pointer.From(props.ProvisioningState))
+					revision.RunningState = string(pointer.From(props.RunningState))
+					revision.Replicas = pointer.From(props.Replicas)
+					revision.TrafficWeight = pointer.From(props.TrafficWeight)
+				}
+
+				if state.ActiveOnly && !revision.Active {
+					continue
+				}
+
+				revisions = append(revisions, revision)
+			}
+			state.Revisions = revisions
+			state.ContainerAppId = id.ID()
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -26,6 +26,12 @@ const (
 	WorkloadProfileSkuE8          WorkloadProfileSku = "E8"
 	WorkloadProfileSkuE16         WorkloadProfileSku = "E16"
 	WorkloadProfileSkuE32         WorkloadProfileSku = "E32"
+
+	WorkloadProfileSkuConsumptionGPUNC24A100 WorkloadProfileSku = "Consumption-GPU-NC24-A100"
+	WorkloadProfileSkuConsumptionGPUNC8asT4  WorkloadProfileSku = "Consumption-GPU-NC8as-T4"
+	WorkloadProfileSkuNC24A100               WorkloadProfileSku = "NC24-A100"
+	WorkloadProfileSkuNC48A100               WorkloadProfileSku = "NC48-A100"
+	WorkloadProfileSkuNC96A100               WorkloadProfileSku = "NC96-A100"
 )
 
 func PossibleValuesForWorkloadProfileSku() []string {
@@ -39,9 +45,20 @@ func PossibleValuesForWorkloadProfileSku() []string {
 		string(WorkloadProfileSkuE8),
 		string(WorkloadProfileSkuE16),
 		string(WorkloadProfileSkuE32),
+		string(WorkloadProfileSkuConsumptionGPUNC24A100),
+		string(WorkloadProfileSkuConsumptionGPUNC8asT4),
+		string(WorkloadProfileSkuNC24A100),
+		string(WorkloadProfileSkuNC48A100),
+		string(WorkloadProfileSkuNC96A100),
 	}
 }
 
+// IsConsumptionWorkloadProfileSku returns whether the Workload Profile type is billed per use (i.e. `Consumption` or
+// one of the serverless `Consumption-GPU-*` types) and therefore doesn't support `minimum_count` or `maximum_count`.
+func IsConsumptionWorkloadProfileSku(input string) bool {
+	return strings.EqualFold(input, string(WorkloadProfileSkuConsumption)) || strings.HasPrefix(strings.ToLower(input), "consumption-gpu-")
+}
+
 type WorkloadProfileModel struct {
 	MaximumCount        int64  `tfschema:"maximum_count"`
 	MinimumCount        int64  `tfschema:"minimum_count"`
@@ -95,13 +112,16 @@ func ExpandWorkloadProfiles(input []WorkloadProfileModel) *[]managedenvironments
 			Name: v.Name,
 		}
 
-		if v.Name != string(WorkloadProfileSkuConsumption) {
+		switch {
+		case v.Name == string(WorkloadProfileSkuConsumption):
+			r.WorkloadProfileType = string(WorkloadProfileSkuConsumption)
+			consumptionDefined = true
+		case IsConsumptionWorkloadProfileSku(v.WorkloadProfileType):
+			r.WorkloadProfileType = v.WorkloadProfileType
+		default:
 			r.WorkloadProfileType = v.WorkloadProfileType
 			r.MaximumCount = pointer.To(v.MaximumCount)
 			r.MinimumCount = pointer.To(v.MinimumCount)
-		} else {
-			r.WorkloadProfileType = string(WorkloadProfileSkuConsumption)
-			consumptionDefined = true
 		}
 
 		result = append(result, r)
@@ -906,7 +906,7 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 			}
 
 			if existing.Model.Properties.Trigger != nil {
-				if !metadata.ResourceData.HasChange("source_triggers") && existing.Model.Properties.Trigger.SourceTriggers != nil {
+				if !metadata.ResourceData.HasChange("source_trigger") && existing.Model.Properties.Trigger.SourceTriggers != nil {
 					// For update that is not affecting source_triggers, we need to patch the source_triggers to include the properties missing in the response of GET.
 					existing.Model.Properties.Trigger.SourceTriggers = patchRegistryTaskTriggerSourceTrigger(*existing.Model.Properties.Trigger.SourceTriggers, model)
 				}
@@ -1135,7 +1135,7 @@ func flattenRegistryTaskTimerTriggers(triggers *[]tasks.TimerTrigger) []TimerTri
 	out := make([]TimerTrigger, 0, len(*triggers))
 	for _, trigger := range *triggers {
 		obj := TimerTrigger{
-			Enabled: *trigger.Status == tasks.TriggerStatusEnabled,
+			Enabled: pointer.From(trigger.Status) == tasks.TriggerStatusEnabled,
 		}
 		obj.Name = trigger.Name
 		obj.Schedule = trigger.Schedule
@@ -155,6 +155,38 @@ func TestAccContainerRegistryTask_encodedTaskStep(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistryTask_encodedTaskStepMultiStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+
+	preCheckGithubRepo(t)
+
+	r := ContainerRegistryTaskResource{
+		githubRepo: githubRepo{
+			url:   os.Getenv("ARM_TEST_ACR_TASK_GITHUB_REPO_URL"),
+			token: os.Getenv("ARM_TEST_ACR_TASK_GITHUB_USER_TOKEN"),
+		},
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encodedTaskStepMultiStep(data, "0 21 * * *", "v1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_step.0.context_access_token"),
+		{
+			// the timer trigger and values file are updated in-place
+			Config: r.encodedTaskStepMultiStep(data, "0 12 * * *", "v2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("timer_trigger.0.schedule").HasValue("0 12 * * *"),
+			),
+		},
+		data.ImportStep("encoded_step.0.context_access_token"),
+	})
+}
+
 func TestAccContainerRegistryTask_dockerStepBaseImageTrigger(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
 
@@ -654,6 +686,39 @@ EOF
 `, template, data.RandomInteger, r.githubRepo.url, r.githubRepo.token)
 }
 
+func (r ContainerRegistryTaskResource) encodedTaskStepMultiStep(data acceptance.TestData, schedule, tag string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  platform {
+    os = "Linux"
+  }
+  encoded_step {
+    task_content         = <<EOF
+version: v1.1.0
+steps:
+  - build: -t $Registry/hello-world:{{.Values.tag}} .
+  - push:
+    - $Registry/hello-world:{{.Values.tag}}
+EOF
+    value_content        = <<EOF
+tag: %s
+EOF
+    context_path         = "%s"
+    context_access_token = "%s"
+  }
+  timer_trigger {
+    name     = "nightly"
+    schedule = "%s"
+  }
+}
+`, template, data.RandomInteger, tag, r.githubRepo.url, r.githubRepo.token, schedule)
+}
+
 func (r ContainerRegistryTaskResource) dockerStepBaseImageTrigger(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -25,6 +26,8 @@ var _ sdk.Resource = ContainerRegistryTaskScheduleResource{}
 
 type ContainerRegistryTaskScheduleModel struct {
 	TaskId string `tfschema:"container_registry_task_id"`
+	RunId  string `tfschema:"run_id"`
+	LogUrl string `tfschema:"log_url"`
 }
 
 func (r ContainerRegistryTaskScheduleResource) Arguments() map[string]*pluginsdk.Schema {
@@ -39,7 +42,18 @@ func (r ContainerRegistryTaskScheduleResource) Arguments() map[string]*pluginsdk
 }
 
 func (r ContainerRegistryTaskScheduleResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"run_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"log_url": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
 }
 
 func (r ContainerRegistryTaskScheduleResource) ResourceType() string {
@@ -142,7 +156,24 @@ func (r ContainerRegistryTaskScheduleResource) Create() sdk.ResourceFunc {
 				Timeout:                   time.Until(timeout),
 			}
 			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-				return fmt.Errorf("waiting for scheduled task to finish: %+v", err)
+				// the run isn't stored in the state when it fails, so the logs are surfaced in the error instead
+				if logUrl, logErr := containerRegistryTaskRunLogUrl(ctx, runsClient, runId); logErr == nil && logUrl != "" {
+					return fmt.Errorf("waiting for scheduled %s to finish (the logs of the run can be downloaded from %q): %+v", runId, logUrl, err)
+				}
+				return fmt.Errorf("waiting for scheduled %s to finish: %+v", runId, err)
+			}
+
+			// the run has succeeded at this point, so failing to retrieve the logs shouldn't fail the creation
+			logUrl, err := containerRegistryTaskRunLogUrl(ctx, runsClient, runId)
+			if err != nil {
+				log.Printf("[WARN] retrieving the log url for %s: %+v", runId, err)
+			}
+
+			// the run is only known when it has been scheduled, therefore setting it to the resource data here.
+			model.RunId = runId.ID()
+			model.LogUrl = logUrl
+			if err := metadata.Encode(&model); err != nil {
+				return fmt.Errorf("encoding model and store into state: %+v", err)
 			}
 
 			metadata.SetID(parse.NewContainerRegistryTaskScheduleID(taskId.SubscriptionId, taskId.ResourceGroupName, taskId.RegistryName, taskId.TaskName, "schedule"))
@@ -160,7 +191,13 @@ func (r ContainerRegistryTaskScheduleResource) Read() sdk.ResourceFunc {
 			if err != nil {
 				return err
 			}
-			model := ContainerRegistryTaskScheduleModel{TaskId: tasks.NewTaskID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TaskName).ID()}
+			var model ContainerRegistryTaskScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the run_id and log_url are only known when the task is scheduled, so are retained from the state
+			model.TaskId = tasks.NewTaskID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TaskName).ID()
 			return metadata.Encode(&model)
 		},
 	}
@@ -174,3 +211,16 @@ func (r ContainerRegistryTaskScheduleResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func containerRegistryTaskRunLogUrl(ctx context.Context, client *runs.RunsClient, id runs.RunId) (string, error) {
+	resp, err := client.GetLogSasUrl(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.Model == nil {
+		return "", nil
+	}
+
+	return pointer.From(resp.Model.LogLink), nil
+}
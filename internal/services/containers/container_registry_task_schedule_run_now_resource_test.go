@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -33,16 +34,28 @@ func TestAccContainerRegistryTaskSchedule_basic(t *testing.T) {
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
 			Config: r.basic(data, r.dockerTaskStep),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("run_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("log_url").IsNotEmpty(),
+			),
 		},
-		data.ImportStep(),
+		data.ImportStep("run_id", "log_url"),
 		{
 			Config: r.basic(data, r.fileTaskStep),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("run_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("log_url").IsNotEmpty(),
+			),
 		},
-		data.ImportStep(),
+		data.ImportStep("run_id", "log_url"),
 		{
 			Config: r.basic(data, r.encodedTaskStep),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("run_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("log_url").IsNotEmpty(),
+			),
 		},
-		data.ImportStep(),
+		data.ImportStep("run_id", "log_url"),
 	})
 }
 
@@ -945,6 +945,52 @@ func TestAccKubernetesCluster_networkPluginModeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_networkPluginKubenetToOverlay(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.networkPluginMigration(data, "kubenet", "", "", "azure"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.networkPluginMigration(data, "azure", "overlay", "", "azure"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_profile.0.network_plugin_mode").HasValue("overlay"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesCluster_networkPolicyAzureToCilium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.networkPluginMigration(data, "azure", "overlay", "azure", "azure"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.networkPluginMigration(data, "azure", "overlay", "cilium", "cilium"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_profile.0.network_policy").HasValue("cilium"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesCluster_ebpfDataPlane(t *testing.T) {
 	if features.FourPointOhBeta() {
 		t.Skipf("Skipping since `ebpf_data_plane` has been removed in 4.0")
@@ -4291,6 +4337,53 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, "westcentralus", data.RandomInteger)
 }
 
+func (KubernetesClusterResource) networkPluginMigration(data acceptance.TestData, networkPlugin, networkPluginMode, networkPolicy, networkDataPlane string) string {
+	networkPluginModeBlock := ""
+	if networkPluginMode != "" {
+		networkPluginModeBlock = fmt.Sprintf("network_plugin_mode = %q", networkPluginMode)
+	}
+	networkPolicyBlock := ""
+	if networkPolicy != "" {
+		networkPolicyBlock = fmt.Sprintf("network_policy = %q", networkPolicy)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[2]d"
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+  identity {
+    type = "SystemAssigned"
+  }
+  network_profile {
+    pod_cidr           = "192.168.0.0/16"
+    network_plugin     = %[3]q
+    network_data_plane = %[6]q
+    %[4]s
+    %[5]s
+  }
+}
+`, data.Locations.Primary, data.RandomInteger, networkPlugin, networkPluginModeBlock, networkPolicyBlock, networkDataPlane)
+}
+
 func (KubernetesClusterResource) clusterPoolNodePublicIPTags(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -100,6 +100,10 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				return true
 			}),
 			pluginsdk.ForceNewIfChange("network_profile.0.network_data_plane", func(ctx context.Context, old, new, meta interface{}) bool {
+				// upgrading from the Azure data plane to Cilium can be done in-place
+				if strings.EqualFold(old.(string), string(managedclusters.NetworkDataplaneAzure)) && strings.EqualFold(new.(string), string(managedclusters.NetworkDataplaneCilium)) {
+					return false
+				}
 				return old != ""
 			}),
 			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
@@ -111,19 +115,53 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("network_profile.0.network_plugin_mode", func(ctx context.Context, _, new, meta interface{}) bool {
 				return !strings.EqualFold(new.(string), string(managedclusters.NetworkPluginModeOverlay))
 			}),
-			pluginsdk.ForceNewIfChange("network_profile.0.network_policy", func(ctx context.Context, old, new, meta interface{}) bool {
-				// Following scenarios are not supported as in-place update:
-				// * Updating from Cilium, Azure or Calico
-				// * Removing network policy if it has been set
+			pluginsdk.ForceNewIf("network_profile.0.network_plugin", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				if !d.HasChange("network_profile.0.network_plugin") {
+					return false
+				}
+
+				// migrating from `kubenet` to Azure CNI Overlay can be done in-place
+				old, new := d.GetChange("network_profile.0.network_plugin")
+				return !(strings.EqualFold(old.(string), string(managedclusters.NetworkPluginKubenet)) &&
+					strings.EqualFold(new.(string), string(managedclusters.NetworkPluginAzure)) &&
+					strings.EqualFold(d.Get("network_profile.0.network_plugin_mode").(string), string(managedclusters.NetworkPluginModeOverlay)))
+			}),
+			pluginsdk.ForceNewIf("network_profile.0.pod_cidr", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				if !d.HasChange("network_profile.0.pod_cidr") {
+					return false
+				}
+
+				// the Pod CIDR can be specified when migrating to Azure CNI Overlay
+				old, new := d.GetChange("network_profile.0.network_plugin_mode")
+				return !(old.(string) == "" && strings.EqualFold(new.(string), string(managedclusters.NetworkPluginModeOverlay)))
+			}),
+			pluginsdk.ForceNewIf("network_profile.0.network_policy", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				if !d.HasChange("network_profile.0.network_policy") {
+					return false
+				}
+
+				// Following scenarios are supported as in-place update:
+				// * Adding a network policy when none has been set
+				// * Migrating from Azure or Calico to Cilium, when the Cilium data plane is used
 				//
 				// Omit network_policy does not uninstall the network policy, since it requires an explicit 'none' value.
 				// And an uninstallation of network policy engine is not GA yet.
 				// Once it is GA, an additional logic is needed to handle the uninstallation of network policy.
-				return old.(string) != ""
+				old, new := d.GetChange("network_profile.0.network_policy")
+				if old.(string) == "" {
+					return false
+				}
+
+				return !(strings.EqualFold(new.(string), string(managedclusters.NetworkPolicyCilium)) && kubernetesClusterUsesCiliumDataPlane(d))
 			}),
 			pluginsdk.ForceNewIfChange("custom_ca_trust_certificates_base64", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			// Node Auto Provisioning can be enabled on an existing cluster, but can't be disabled once enabled
+			pluginsdk.ForceNewIfChange("node_provisioning_profile.0.mode", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(managedclusters.NodeProvisioningModeAuto) && new.(string) != string(managedclusters.NodeProvisioningModeAuto)
+			}),
+			validateKubernetesClusterNodeProvisioningProfile,
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -971,7 +1009,6 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 						"network_plugin": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								string(managedclusters.NetworkPluginAzure),
 								string(managedclusters.NetworkPluginKubenet),
@@ -1045,7 +1082,6 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ForceNew:     true,
 							ValidateFunc: validate.CIDR,
 						},
 
@@ -1213,6 +1249,21 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"node_provisioning_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(managedclusters.PossibleValuesForNodeProvisioningMode(), false),
+						},
+					},
+				},
+			},
+
 			"node_resource_group": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -1969,6 +2020,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 			WindowsProfile:            windowsProfile,
 			MetricsProfile:            metricsProfile,
 			NetworkProfile:            networkProfile,
+			NodeProvisioningProfile:   expandKubernetesClusterNodeProvisioningProfile(d.Get("node_provisioning_profile").([]interface{})),
 			NodeResourceGroup:         utils.String(nodeResourceGroup),
 			DisableLocalAccounts:      utils.Bool(d.Get("local_account_disabled").(bool)),
 			HTTPProxyConfig:           httpProxyConfig,
@@ -2261,14 +2313,29 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 		networkProfile := *existing.Model.Properties.NetworkProfile
 
+		if key := "network_profile.0.network_plugin"; d.HasChange(key) {
+			networkPlugin := d.Get(key).(string)
+			existing.Model.Properties.NetworkProfile.NetworkPlugin = pointer.To(managedclusters.NetworkPlugin(networkPlugin))
+		}
+
+		if key := "network_profile.0.network_plugin_mode"; d.HasChange(key) {
+			networkPluginMode := d.Get(key).(string)
+			existing.Model.Properties.NetworkProfile.NetworkPluginMode = pointer.To(managedclusters.NetworkPluginMode(networkPluginMode))
+		}
+
+		if key := "network_profile.0.pod_cidr"; d.HasChange(key) {
+			podCidr := d.Get(key).(string)
+			existing.Model.Properties.NetworkProfile.PodCidr = pointer.To(podCidr)
+		}
+
+		if key := "network_profile.0.network_policy"; d.HasChange(key) {
+			networkPolicy := d.Get(key).(string)
+			existing.Model.Properties.NetworkProfile.NetworkPolicy = pointer.To(managedclusters.NetworkPolicy(networkPolicy))
+		}
+
 		if networkProfile.LoadBalancerProfile != nil {
 			loadBalancerProfile := *networkProfile.LoadBalancerProfile
 
-			if key := "network_profile.0.network_policy"; d.HasChange(key) {
-				networkPolicy := d.Get(key).(string)
-				existing.Model.Properties.NetworkProfile.NetworkPolicy = pointer.To(managedclusters.NetworkPolicy(networkPolicy))
-			}
-
 			if key := "network_profile.0.load_balancer_profile.0.effective_outbound_ips"; d.HasChange(key) {
 				effectiveOutboundIPs := idsToResourceReferences(d.Get(key))
 				loadBalancerProfile.EffectiveOutboundIPs = effectiveOutboundIPs
@@ -2521,6 +2588,17 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.Model.Properties.StorageProfile = clusterStorageProfile
 	}
 
+	if d.HasChange("node_provisioning_profile") {
+		updateCluster = true
+		nodeProvisioningProfile := expandKubernetesClusterNodeProvisioningProfile(d.Get("node_provisioning_profile").([]interface{}))
+		if nodeProvisioningProfile == nil {
+			nodeProvisioningProfile = &managedclusters.ManagedClusterNodeProvisioningProfile{
+				Mode: pointer.To(managedclusters.NodeProvisioningModeManual),
+			}
+		}
+		existing.Model.Properties.NodeProvisioningProfile = nodeProvisioningProfile
+	}
+
 	if d.HasChange("workload_autoscaler_profile") {
 		updateCluster = true
 		workloadAutoscalerProfileRaw := d.Get("workload_autoscaler_profile").([]interface{})
@@ -3015,6 +3093,11 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `windows_profile`: %+v", err)
 			}
 
+			nodeProvisioningProfile := flattenKubernetesClusterNodeProvisioningProfile(props.NodeProvisioningProfile, d)
+			if err := d.Set("node_provisioning_profile", nodeProvisioningProfile); err != nil {
+				return fmt.Errorf("setting `node_provisioning_profile`: %+v", err)
+			}
+
 			workloadAutoscalerProfile := flattenKubernetesClusterWorkloadAutoscalerProfile(props.WorkloadAutoScalerProfile)
 			if err := d.Set("workload_autoscaler_profile", workloadAutoscalerProfile); err != nil {
 				return fmt.Errorf("setting `workload_autoscaler_profile`: %+v", err)
@@ -3432,6 +3515,35 @@ func flattenKubernetesClusterWindowsProfile(profile *managedclusters.ManagedClus
 	}
 }
 
+func expandKubernetesClusterNodeProvisioningProfile(input []interface{}) *managedclusters.ManagedClusterNodeProvisioningProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	config := input[0].(map[string]interface{})
+	return &managedclusters.ManagedClusterNodeProvisioningProfile{
+		Mode: pointer.To(managedclusters.NodeProvisioningMode(config["mode"].(string))),
+	}
+}
+
+func flattenKubernetesClusterNodeProvisioningProfile(input *managedclusters.ManagedClusterNodeProvisioningProfile, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil || input.Mode == nil {
+		return []interface{}{}
+	}
+
+	// the API returns `Manual` when Node Auto Provisioning hasn't been configured
+	mode := pointer.From(input.Mode)
+	if mode == managedclusters.NodeProvisioningModeManual && len(d.Get("node_provisioning_profile").([]interface{})) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": string(mode),
+		},
+	}
+}
+
 func flattenKubernetesClusterWorkloadAutoscalerProfile(profile *managedclusters.ManagedClusterWorkloadAutoScalerProfile) []interface{} {
 	// The API always returns an empty WorkloadAutoScalerProfile object even if none of these values have ever been set
 	if profile == nil || (profile.Keda == nil && profile.VerticalPodAutoscaler == nil) {
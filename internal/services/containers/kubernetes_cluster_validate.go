@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -318,3 +319,47 @@ func validateNodePoolSupportsVersion(ctx context.Context, client *client.Client,
 
 	return nil
 }
+
+// validateKubernetesClusterNodeProvisioningProfile ensures the prerequisites of Node Auto Provisioning are met at plan time
+func validateKubernetesClusterNodeProvisioningProfile(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	mode := d.Get("node_provisioning_profile.0.mode").(string)
+	if mode != string(managedclusters.NodeProvisioningModeAuto) {
+		return nil
+	}
+
+	for _, key := range []string{"network_profile.0.network_plugin", "network_profile.0.network_plugin_mode", "network_profile.0.network_data_plane"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	networkPlugin := d.Get("network_profile.0.network_plugin").(string)
+	networkPluginMode := d.Get("network_profile.0.network_plugin_mode").(string)
+	if !strings.EqualFold(networkPlugin, string(managedclusters.NetworkPluginAzure)) ||
+		!strings.EqualFold(networkPluginMode, string(managedclusters.NetworkPluginModeOverlay)) ||
+		!kubernetesClusterUsesCiliumDataPlane(d) {
+		return fmt.Errorf("when `node_provisioning_profile.0.mode` is set to `Auto`, `network_profile.0.network_plugin` must be `azure`, `network_profile.0.network_plugin_mode` must be `overlay` and `network_profile.0.network_data_plane` must be `cilium`")
+	}
+
+	autoScalingField := "auto_scaling_enabled"
+	if !features.FourPointOhBeta() {
+		autoScalingField = "enable_auto_scaling"
+	}
+	if d.Get(fmt.Sprintf("default_node_pool.0.%s", autoScalingField)).(bool) {
+		return fmt.Errorf("`default_node_pool.0.%s` cannot be enabled when `node_provisioning_profile.0.mode` is set to `Auto`", autoScalingField)
+	}
+
+	return nil
+}
+
+func kubernetesClusterUsesCiliumDataPlane(d *pluginsdk.ResourceDiff) bool {
+	if strings.EqualFold(d.Get("network_profile.0.network_data_plane").(string), string(managedclusters.NetworkDataplaneCilium)) {
+		return true
+	}
+
+	if !features.FourPointOhBeta() {
+		return strings.EqualFold(d.Get("network_profile.0.ebpf_data_plane").(string), string(managedclusters.NetworkDataplaneCilium))
+	}
+
+	return false
+}
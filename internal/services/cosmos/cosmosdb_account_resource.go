@@ -20,7 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/cosmosdb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -1079,7 +1079,6 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	// Normalize Locations...
 	cosmosLocations := make([]cosmosdb.Location, 0)
-	cosmosLocationsMap := map[string]cosmosdb.Location{}
 
 	if existing.Model.Properties.Locations != nil {
 		for _, l := range *existing.Model.Properties.Locations {
@@ -1091,7 +1090,6 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 			}
 
 			cosmosLocations = append(cosmosLocations, location)
-			cosmosLocationsMap[azure.NormalizeLocation(*location.LocationName)] = location
 		}
 	}
 
@@ -1319,52 +1317,9 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 			log.Printf("[INFO] [SKIP] AzureRM Cosmos DB Account: Updating 'EnableMultipleWriteLocations' [NO CHANGE]")
 		}
 
-		// determine if any locations have been renamed/priority reordered and remove them
-		updateLocations := false
-		for _, configLoc := range configLocations {
-			if cosmosLoc, ok := cosmosLocationsMap[pointer.From(configLoc.LocationName)]; ok {
-				// is the location in the config also in the database with the same 'FailoverPriority'?
-				if pointer.From(configLoc.FailoverPriority) != pointer.From(cosmosLoc.FailoverPriority) {
-					// The Failover Priority has been changed in the config...
-					if pointer.From(configLoc.FailoverPriority) == 0 {
-						return fmt.Errorf("cannot change the failover priority of %q location %q to %d", id, pointer.From(configLoc.LocationName), pointer.From(configLoc.FailoverPriority))
-					}
-
-					// since the Locations FailoverPriority changed remove it from the map because
-					// we have to update the Location in the database. The Locations
-					// left in the map after this loop are the Locations that are
-					// the same in the database and in the config file...
-					delete(cosmosLocationsMap, pointer.From(configLoc.LocationName))
-					updateLocations = true
-				}
-			}
-		}
-
-		if updateLocations {
-			log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Removing renamed 'Locations'")
-			locationsUnchanged := make([]cosmosdb.Location, 0, len(cosmosLocationsMap))
-			for _, value := range cosmosLocationsMap {
-				locationsUnchanged = append(locationsUnchanged, value)
-			}
-
-			account.Properties.Locations = locationsUnchanged
-
-			// Update the database...
-			if err = resourceCosmosDbAccountApiCreateOrUpdate(client, ctx, *id, account, d); err != nil {
-				return fmt.Errorf("removing %q renamed `locations`: %+v", id, err)
-			}
-		} else {
-			log.Printf("[INFO] [SKIP] AzureRM Cosmos DB Account: Removing renamed 'Locations' [NO CHANGE]")
-		}
-
 		if d.HasChanges("geo_location") {
 			log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Updating 'Locations'")
-			// add any new/renamed locations
-			account.Properties.Locations = configLocations
-
-			// Update the database locations...
-			err = resourceCosmosDbAccountApiCreateOrUpdate(client, ctx, *id, account, d)
-			if err != nil {
+			if err := resourceCosmosDbAccountUpdateLocations(ctx, client, *id, cosmosLocations, configLocations, d); err != nil {
 				return fmt.Errorf("updating %q `locations`: %+v", id, err)
 			}
 		} else {
@@ -1764,6 +1719,10 @@ func resourceCosmosDbAccountApiCreateOrUpdate(client *cosmosdb.CosmosDBClient, c
 		return fmt.Errorf("creating/updating CosmosDB Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroupName, err)
 	}
 
+	return resourceCosmosDbAccountWaitForLocations(client, ctx, id, account.Properties.Locations, d)
+}
+
+func resourceCosmosDbAccountWaitForLocations(client *cosmosdb.CosmosDBClient, ctx context.Context, id cosmosdb.DatabaseAccountId, desiredLocations []cosmosdb.Location, d *pluginsdk.ResourceData) error {
 	// if a replication location is added or removed it can take some time to provision
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{"Creating", "Updating", "Deleting", "Initializing", "Dequeued", "Enqueued"},
@@ -1792,7 +1751,7 @@ func resourceCosmosDbAccountApiCreateOrUpdate(client *cosmosdb.CosmosDBClient, c
 					}
 				}
 
-				for _, desiredLocation := range account.Properties.Locations {
+				for _, desiredLocation := range desiredLocations {
 					for index, l := range locations {
 						if azure.NormalizeLocation(*desiredLocation.LocationName) == azure.NormalizeLocation(*l.LocationName) {
 							break
@@ -1823,6 +1782,131 @@ func resourceCosmosDbAccountApiCreateOrUpdate(client *cosmosdb.CosmosDBClient, c
 	return nil
 }
 
+// resourceCosmosDbAccountUpdateLocations applies changes to the `geo_location` blocks using the dedicated location
+// APIs rather than re-sending the full account, since the latter frequently conflicts with background operations:
+//   - new read regions are added by patching only the `locations` of the account
+//   - failover priorities (including the write region) are changed using the failover priority change API
+//   - regions which are no longer configured are removed by patching only the `locations` of the account
+func resourceCosmosDbAccountUpdateLocations(ctx context.Context, client *cosmosdb.CosmosDBClient, id cosmosdb.DatabaseAccountId, existingLocations []cosmosdb.Location, configLocations []cosmosdb.Location, d *pluginsdk.ResourceData) error {
+	existingByName := make(map[string]cosmosdb.Location, len(existingLocations))
+	maxExistingPriority := int64(-1)
+	for _, l := range existingLocations {
+		existingByName[azure.NormalizeLocation(pointer.From(l.LocationName))] = l
+		if priority := pointer.From(l.FailoverPriority); priority > maxExistingPriority {
+			maxExistingPriority = priority
+		}
+	}
+
+	configByName := make(map[string]cosmosdb.Location, len(configLocations))
+	maxConfigPriority := int64(-1)
+	for _, l := range configLocations {
+		configByName[azure.NormalizeLocation(pointer.From(l.LocationName))] = l
+		if priority := pointer.From(l.FailoverPriority); priority > maxConfigPriority {
+			maxConfigPriority = priority
+		}
+	}
+
+	// 1. add any new regions as read regions, with a failover priority after the existing regions
+	current := make([]cosmosdb.Location, 0)
+	for _, l := range existingLocations {
+		current = append(current, cosmosdb.Location{
+			LocationName:     l.LocationName,
+			FailoverPriority: l.FailoverPriority,
+			IsZoneRedundant:  l.IsZoneRedundant,
+		})
+	}
+
+	added := false
+	for _, l := range configLocations {
+		if _, ok := existingByName[azure.NormalizeLocation(pointer.From(l.LocationName))]; ok {
+			continue
+		}
+
+		maxExistingPriority++
+		current = append(current, cosmosdb.Location{
+			LocationName:     l.LocationName,
+			FailoverPriority: pointer.To(maxExistingPriority),
+			IsZoneRedundant:  l.IsZoneRedundant,
+		})
+		added = true
+	}
+
+	if added {
+		log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Adding 'Locations'")
+		if err := resourceCosmosDbAccountPatchLocations(ctx, client, id, current, d); err != nil {
+			return fmt.Errorf("adding locations: %+v", err)
+		}
+	}
+
+	// 2. reorder the failover priorities, regions which are being removed are moved to the end of the list
+	policies := make([]cosmosdb.FailoverPolicy, 0)
+	priorityChanged := false
+	removedPriority := maxConfigPriority
+	for _, l := range current {
+		name := azure.NormalizeLocation(pointer.From(l.LocationName))
+		priority := pointer.From(l.FailoverPriority)
+		desired := priority
+		if configLocation, ok := configByName[name]; ok {
+			desired = pointer.From(configLocation.FailoverPriority)
+		} else {
+			removedPriority++
+			desired = removedPriority
+		}
+
+		if desired != priority {
+			priorityChanged = true
+		}
+
+		policies = append(policies, cosmosdb.FailoverPolicy{
+			LocationName:     l.LocationName,
+			FailoverPriority: pointer.To(desired),
+		})
+	}
+
+	if priorityChanged {
+		log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Updating 'FailoverPriority' of the 'Locations'")
+		if err := client.DatabaseAccountsFailoverPriorityChangeThenPoll(ctx, id, cosmosdb.FailoverPolicies{FailoverPolicies: policies}); err != nil {
+			return fmt.Errorf("changing failover priorities: %+v", err)
+		}
+		if err := resourceCosmosDbAccountWaitForLocations(client, ctx, id, current, d); err != nil {
+			return err
+		}
+	}
+
+	// 3. remove any regions which are no longer configured, and apply changes to zone redundancy
+	requiresPatch := false
+	for _, l := range current {
+		configLocation, ok := configByName[azure.NormalizeLocation(pointer.From(l.LocationName))]
+		if !ok || pointer.From(configLocation.IsZoneRedundant) != pointer.From(l.IsZoneRedundant) {
+			requiresPatch = true
+			break
+		}
+	}
+
+	if requiresPatch {
+		log.Printf("[INFO] Updating AzureRM Cosmos DB Account: Removing 'Locations'")
+		if err := resourceCosmosDbAccountPatchLocations(ctx, client, id, configLocations, d); err != nil {
+			return fmt.Errorf("removing locations: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCosmosDbAccountPatchLocations(ctx context.Context, client *cosmosdb.CosmosDBClient, id cosmosdb.DatabaseAccountId, locations []cosmosdb.Location, d *pluginsdk.ResourceData) error {
+	payload := cosmosdb.DatabaseAccountUpdateParameters{
+		Properties: &cosmosdb.DatabaseAccountUpdateProperties{
+			Locations: pointer.To(locations),
+		},
+	}
+
+	if err := client.DatabaseAccountsUpdateThenPoll(ctx, id, payload); err != nil {
+		return err
+	}
+
+	return resourceCosmosDbAccountWaitForLocations(client, ctx, id, locations, d)
+}
+
 func expandAzureRmCosmosDBAccountConsistencyPolicy(d *pluginsdk.ResourceData) *cosmosdb.ConsistencyPolicy {
 	i := d.Get("consistency_policy").([]interface{})
 	if len(i) == 0 || i[0] == nil {
@@ -7,12 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/cosmosdb"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -81,6 +82,7 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 				Optional: !features.FourPointOhBeta(),
 				Computed: !features.FourPointOhBeta(),
 				ForceNew: true,
+				MaxItems: 3,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -153,6 +155,25 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 				},
 			},
 			"indexing_policy": common.CosmosDbIndexingPolicySchema(),
+
+			"computed_property": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"query": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
@@ -160,6 +181,8 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("analytical_storage_ttl", func(ctx context.Context, old, new, _ interface{}) bool {
 				return (old.(int) == -1 || old.(int) > 0) && new.(int) == 0
 			}),
+			pluginsdk.CustomizeDiffShim(cosmosDbSQLContainerValidatePartitionKey),
+			pluginsdk.CustomizeDiffShim(cosmosDbSQLContainerValidateComputedProperties),
 		),
 	}
 
@@ -237,6 +260,10 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
+	if v, ok := d.GetOk("computed_property"); ok {
+		db.Properties.Resource.ComputedProperties = expandCosmosSQLContainerComputedProperties(v.([]interface{}))
+	}
+
 	if analyticalStorageTTL, ok := d.GetOk("analytical_storage_ttl"); ok {
 		db.Properties.Resource.AnalyticalStorageTtl = utils.Int64(int64(analyticalStorageTTL.(int)))
 	}
@@ -320,6 +347,10 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
+	if v, ok := d.GetOk("computed_property"); ok {
+		db.Properties.Resource.ComputedProperties = expandCosmosSQLContainerComputedProperties(v.([]interface{}))
+	}
+
 	if analyticalStorageTTL, ok := d.GetOk("analytical_storage_ttl"); ok {
 		db.Properties.Resource.AnalyticalStorageTtl = utils.Int64(int64(analyticalStorageTTL.(int)))
 	}
@@ -400,6 +431,10 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 					}
 				}
 
+				if err := d.Set("computed_property", flattenCosmosSQLContainerComputedProperties(res.ComputedProperties)); err != nil {
+					return fmt.Errorf("setting `computed_property`: %+v", err)
+				}
+
 				if analyticalStorageTTL := res.AnalyticalStorageTtl; analyticalStorageTTL != nil {
 					d.Set("analytical_storage_ttl", analyticalStorageTTL)
 				}
@@ -464,6 +499,102 @@ func resourceCosmosDbSQLContainerDelete(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
+// cosmosDbSQLContainerValidatePartitionKey ensures the partition key paths are consistent with the partition key kind,
+// hierarchical partition keys use the `MultiHash` kind with version `2` and between 2 and 3 paths
+func cosmosDbSQLContainerValidatePartitionKey(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("partition_key_paths") {
+		return nil
+	}
+
+	paths := make([]string, 0)
+	for _, v := range d.Get("partition_key_paths").([]interface{}) {
+		if v != nil {
+			paths = append(paths, v.(string))
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("the partition key path %q must start with a `/`", path)
+		}
+		if _, ok := seen[path]; ok {
+			return fmt.Errorf("the partition key path %q is specified more than once in `partition_key_paths`", path)
+		}
+		seen[path] = struct{}{}
+	}
+
+	switch kind := d.Get("partition_key_kind").(string); kind {
+	case string(cosmosdb.PartitionKindHash):
+		if len(paths) != 1 {
+			return fmt.Errorf("exactly one path must be specified in `partition_key_paths` when `partition_key_kind` is %q, use %q for hierarchical partition keys", kind, string(cosmosdb.PartitionKindMultiHash))
+		}
+	case string(cosmosdb.PartitionKindMultiHash):
+		if len(paths) < 2 {
+			return fmt.Errorf("between 2 and 3 paths must be specified in `partition_key_paths` when `partition_key_kind` is %q", kind)
+		}
+		if d.Get("partition_key_version").(int) != 2 {
+			return fmt.Errorf("`partition_key_version` must be set to `2` when `partition_key_kind` is %q", kind)
+		}
+	}
+
+	return nil
+}
+
+func cosmosDbSQLContainerValidateComputedProperties(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	names := make(map[string]struct{})
+	for _, v := range d.Get("computed_property").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		name := v.(map[string]interface{})["name"].(string)
+		if name == "" {
+			continue
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("the `computed_property` %q is specified more than once", name)
+		}
+		names[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func expandCosmosSQLContainerComputedProperties(input []interface{}) *[]cosmosdb.ComputedProperty {
+	output := make([]cosmosdb.ComputedProperty, 0)
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		raw := v.(map[string]interface{})
+		output = append(output, cosmosdb.ComputedProperty{
+			Name:  pointer.To(raw["name"].(string)),
+			Query: pointer.To(raw["query"].(string)),
+		})
+	}
+
+	return &output
+}
+
+func flattenCosmosSQLContainerComputedProperties(input *[]cosmosdb.ComputedProperty) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, map[string]interface{}{
+			"name":  pointer.From(v.Name),
+			"query": pointer.From(v.Query),
+		})
+	}
+
+	return output
+}
+
 func expandCosmosSQLContainerUniqueKeys(s *pluginsdk.Set) *[]cosmosdb.UniqueKey {
 	i := s.List()
 	if len(i) == 0 || i[0] == nil {
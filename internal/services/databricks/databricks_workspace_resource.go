@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
@@ -139,6 +142,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"default_storage_firewall_enabled"},
+				ValidateFunc: accessconnector.ValidateAccessConnectorID,
 			},
 
 			"network_security_group_rules_required": {
@@ -602,37 +606,26 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	if defaultStorageFirewallEnabledRaw {
-		accessConnectorProperties := workspaces.WorkspacePropertiesAccessConnector{}
 		accessConnectorIdRaw := d.Get("access_connector_id").(string)
 		accessConnectorId, err := accessconnector.ParseAccessConnectorID(accessConnectorIdRaw)
-
 		if err != nil {
 			return fmt.Errorf("parsing Access Connector ID %s: %+v", accessConnectorIdRaw, err)
 		}
 
 		accessConnector, err := acClient.Get(ctx, *accessConnectorId)
 		if err != nil {
-			return fmt.Errorf("retrieving Access Connector %s: %+v", accessConnectorId.AccessConnectorName, err)
+			return fmt.Errorf("retrieving %s: %+v", *accessConnectorId, err)
 		}
 
-		if accessConnector.Model.Identity != nil {
-
-			accIdentityId := ""
-			for raw := range accessConnector.Model.Identity.IdentityIds {
-				id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
-				if err != nil {
-					return fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
-				}
-				accIdentityId = id.ID()
-				break
-			}
-
-			accessConnectorProperties.Id = *accessConnector.Model.Id
-			accessConnectorProperties.IdentityType = workspaces.IdentityType(accessConnector.Model.Identity.Type)
-			accessConnectorProperties.UserAssignedIdentityId = &accIdentityId
+		accessConnectorProperties, err := expandWorkspaceAccessConnector(*accessConnectorId, accessConnector.Model)
+		if err != nil {
+			return err
 		}
 
-		workspace.Properties.AccessConnector = &accessConnectorProperties
+		// the network rules of the storage account within the managed resource group aren't managed here, the managed resource group
+		// is protected by a deny assignment - instead the Databricks service locks down the storage account once the firewall is
+		// enabled, and grants the Access Connector's identity access to it
+		workspace.Properties.AccessConnector = accessConnectorProperties
 		workspace.Properties.DefaultStorageFirewall = &defaultStorageFirewallEnabled
 	}
 
@@ -742,7 +735,11 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 		if defaultStorageFirewall := model.Properties.DefaultStorageFirewall; defaultStorageFirewall != nil {
 			d.Set("default_storage_firewall_enabled", *defaultStorageFirewall != workspaces.DefaultStorageFirewallDisabled)
 			if model.Properties.AccessConnector != nil {
-				d.Set("access_connector_id", model.Properties.AccessConnector.Id)
+				accessConnectorId, err := accessconnector.ParseAccessConnectorIDInsensitively(model.Properties.AccessConnector.Id)
+				if err != nil {
+					return err
+				}
+				d.Set("access_connector_id", accessConnectorId.ID())
 			}
 		}
 
@@ -966,6 +963,45 @@ func flattenWorkspaceCustomParameters(input *workspaces.WorkspaceCustomParameter
 	return []interface{}{parameters}, backendAddressPoolId
 }
 
+// expandWorkspaceAccessConnector returns the Access Connector used by the workspace to access the default storage account
+// once its firewall is enabled, which must use a Managed Identity of the Access Connector. The System Assigned Identity
+// is preferred when the Access Connector has both types of Identity, otherwise the first User Assigned Identity (sorted by ID) is used.
+func expandWorkspaceAccessConnector(id accessconnector.AccessConnectorId, model *accessconnector.AccessConnector) (*workspaces.WorkspacePropertiesAccessConnector, error) {
+	if model == nil || model.Identity == nil {
+		return nil, fmt.Errorf("`default_storage_firewall_enabled` requires the %s to have a Managed Identity", id)
+	}
+
+	output := workspaces.WorkspacePropertiesAccessConnector{
+		Id: id.ID(),
+	}
+
+	switch model.Identity.Type {
+	case identity.TypeSystemAssigned, identity.TypeSystemAssignedUserAssigned:
+		output.IdentityType = workspaces.IdentityTypeSystemAssigned
+	case identity.TypeUserAssigned:
+		output.IdentityType = workspaces.IdentityTypeUserAssigned
+
+		// map iteration order is random, so the IDs are sorted to consistently pick the same User Assigned Identity
+		userAssignedIdentityIds := make([]string, 0)
+		for raw := range model.Identity.IdentityIds {
+			userAssignedIdentityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
+			}
+			userAssignedIdentityIds = append(userAssignedIdentityIds, userAssignedIdentityId.ID())
+		}
+		if len(userAssignedIdentityIds) == 0 {
+			return nil, fmt.Errorf("`default_storage_firewall_enabled` requires the %s to have a Managed Identity", id)
+		}
+		sort.Strings(userAssignedIdentityIds)
+		output.UserAssignedIdentityId = pointer.To(userAssignedIdentityIds[0])
+	default:
+		return nil, fmt.Errorf("`default_storage_firewall_enabled` requires the %s to have a Managed Identity", id)
+	}
+
+	return &output, nil
+}
+
 func expandWorkspaceCustomParameters(input []interface{}, customerManagedKeyEnabled, infrastructureEncryptionEnabled bool, backendAddressPoolName, loadBalancerId string) (workspaceCustomParameters *workspaces.WorkspaceCustomParameters, publicSubnetAssociation, privateSubnetAssociation *string) {
 	if len(input) == 0 || input[0] == nil {
 		// This will be hit when there are no custom params set but we still
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/resources"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// The Private Endpoint Connections on the target resource of a Managed Private Endpoint are exposed by each Resource
+// Provider using its own API Versions, however the generic Resources client always uses the API Version of the
+// Resources API. This wraps the generic Resources client so that the API Version can be specified for each request.
+
+type ResourcesClient struct {
+	OriginalClient *resources.ResourcesClient
+}
+
+type PrivateEndpointConnection struct {
+	Id         *string                              `json:"id,omitempty"`
+	Properties *PrivateEndpointConnectionProperties `json:"properties,omitempty"`
+}
+
+type PrivateEndpointConnectionProperties struct {
+	PrivateEndpoint *struct {
+		Id *string `json:"id,omitempty"`
+	} `json:"privateEndpoint,omitempty"`
+	PrivateLinkServiceConnectionState *struct {
+		Status *string `json:"status,omitempty"`
+	} `json:"privateLinkServiceConnectionState,omitempty"`
+}
+
+type ListPrivateEndpointConnectionsOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *[]PrivateEndpointConnection
+}
+
+type apiVersionOptions struct {
+	apiVersion string
+}
+
+func (o apiVersionOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o apiVersionOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o apiVersionOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", o.apiVersion)
+	return &out
+}
+
+// ListPrivateEndpointConnections lists the Private Endpoint Connections on the specified resource
+func (c ResourcesClient) ListPrivateEndpointConnections(ctx context.Context, resourceId string, apiVersion string) (result ListPrivateEndpointConnectionsOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: apiVersionOptions{apiVersion: apiVersion},
+		Path:          fmt.Sprintf("%s/privateEndpointConnections", resourceId),
+	}
+
+	req, err := c.OriginalClient.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]PrivateEndpointConnection `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+	return
+}
+
+// CreateOrUpdateByIdThenPoll creates or updates the specified resource using the specified API Version, then polls until it's completed
+func (c ResourcesClient) CreateOrUpdateByIdThenPoll(ctx context.Context, resourceId string, apiVersion string, input resources.GenericResource) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: apiVersionOptions{apiVersion: apiVersion},
+		Path:          resourceId,
+	}
+
+	req, err := c.OriginalClient.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdateById: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.OriginalClient.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdateById: %+v", err)
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/managedprivateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/managedvirtualnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/tombuildsstuff/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
@@ -20,6 +21,9 @@ type Client struct {
 	ManagedPrivateEndpoints *managedprivateendpoints.ManagedPrivateEndpointsClient
 	ManagedVirtualNetworks  *managedvirtualnetworks.ManagedVirtualNetworksClient
 
+	// Resources is used to approve the Private Endpoint Connections created by Managed Private Endpoints
+	Resources *resources.ResourcesClient
+
 	// TODO: convert to using hashicorp/go-azure-sdk
 	DataFlowClient            *datafactory.DataFlowsClient
 	DatasetClient             *datafactory.DatasetsClient
@@ -54,6 +58,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(managedVirtualNetworksClient.Client, o.Authorizers.ResourceManager)
 
+	resourcesClient, err := resources.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resources client: %+v", err)
+	}
+	o.Configure(resourcesClient.Client, o.Authorizers.ResourceManager)
+
 	// TODO: port the below operations to use `hashicorp/go-azure-sdk` in time
 	dataFlowClient := datafactory.NewDataFlowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataFlowClient.Client, o.ResourceManagerAuthorizer)
@@ -78,6 +88,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		Credentials:             credentialsClient,
 		ManagedPrivateEndpoints: managedPrivateEndpointsClient,
 		ManagedVirtualNetworks:  managedVirtualNetworksClient,
+		Resources:               resourcesClient,
 
 		// TODO: port to `hashicorp/go-azure-sdk`
 		DataFlowClient:            &dataFlowClient,
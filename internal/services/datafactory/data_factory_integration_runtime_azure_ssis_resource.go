@@ -4,6 +4,7 @@
 package datafactory
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -43,6 +44,30 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				// the API has no authentication mode for the SSIS catalog, instead the managed identity is used when the
+				// credentials are omitted - so disabling the managed identity requires the credentials to be specified
+				catalogInfo := d.GetRawConfig().GetAttr("catalog_info")
+				if catalogInfo.IsNull() || !catalogInfo.IsKnown() || catalogInfo.LengthInt() == 0 {
+					return nil
+				}
+
+				managedIdentityEnabled := catalogInfo.AsValueSlice()[0].GetAttr("managed_identity_enabled")
+				if managedIdentityEnabled.IsNull() || !managedIdentityEnabled.IsKnown() || managedIdentityEnabled.True() {
+					return nil
+				}
+
+				for _, key := range []string{"catalog_info.0.administrator_login", "catalog_info.0.administrator_password"} {
+					if d.NewValueKnown(key) && d.Get(key).(string) == "" {
+						return fmt.Errorf("`administrator_login` and `administrator_password` must be specified within `catalog_info` when `managed_identity_enabled` is `false`")
+					}
+				}
+
+				return nil
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -243,15 +268,23 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 						"administrator_login": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  validation.StringIsNotEmpty,
+							ConflictsWith: []string{"catalog_info.0.managed_identity_enabled"},
 						},
 						"administrator_password": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							Sensitive:    true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringIsNotEmpty,
+							ConflictsWith: []string{"catalog_info.0.managed_identity_enabled"},
+						},
+						"managed_identity_enabled": {
+							Type:          pluginsdk.TypeBool,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"catalog_info.0.administrator_login", "catalog_info.0.administrator_password"},
 						},
 						"pricing_tier": {
 							Type:     pluginsdk.TypeString,
@@ -1015,14 +1048,18 @@ func flattenDataFactoryIntegrationRuntimeAzureSsisCatalogInfo(ssisProperties *da
 		administratorPassword = adminPassword.(string)
 	}
 
+	// the API doesn't return the authentication mode, however the credentials are omitted when using the managed identity
+	managedIdentityEnabled := ssisProperties.CatalogAdminUserName == nil
+
 	return []interface{}{
 		map[string]interface{}{
-			"server_endpoint":        serverEndpoint,
-			"pricing_tier":           pricingTier,
-			"elastic_pool_name":      elasticPoolName,
-			"administrator_login":    catalogAdminUserName,
-			"administrator_password": administratorPassword,
-			"dual_standby_pair_name": dualStandbyPairName,
+			"server_endpoint":          serverEndpoint,
+			"pricing_tier":             pricingTier,
+			"elastic_pool_name":        elasticPoolName,
+			"administrator_login":      catalogAdminUserName,
+			"administrator_password":   administratorPassword,
+			"dual_standby_pair_name":   dualStandbyPairName,
+			"managed_identity_enabled": managedIdentityEnabled,
 		},
 	}
 }
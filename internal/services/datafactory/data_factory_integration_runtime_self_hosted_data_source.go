@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type IntegrationRuntimeSelfHostedDataSource struct{}
+
+type IntegrationRuntimeSelfHostedDataSourceModel struct {
+	Name                                     string                             `tfschema:"name"`
+	DataFactoryID                            string                             `tfschema:"data_factory_id"`
+	Description                              string                             `tfschema:"description"`
+	SelfContainedInteractiveAuthoringEnabled bool                               `tfschema:"self_contained_interactive_authoring_enabled"`
+	Node                                     []IntegrationRuntimeSelfHostedNode `tfschema:"node"`
+}
+
+type IntegrationRuntimeSelfHostedNode struct {
+	Name        string `tfschema:"name"`
+	MachineName string `tfschema:"machine_name"`
+	Status      string `tfschema:"status"`
+	Version     string `tfschema:"version"`
+}
+
+var _ sdk.DataSource = IntegrationRuntimeSelfHostedDataSource{}
+
+func (d IntegrationRuntimeSelfHostedDataSource) ModelObject() interface{} {
+	return &IntegrationRuntimeSelfHostedDataSourceModel{}
+}
+
+func (d IntegrationRuntimeSelfHostedDataSource) ResourceType() string {
+	return "azurerm_data_factory_integration_runtime_self_hosted"
+}
+
+func (d IntegrationRuntimeSelfHostedDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+				`Invalid name for Self-Hosted Integration Runtime: minimum 3 characters, must start and end with a number or a letter, may only consist of letters, numbers and dashes and no consecutive dashes.`,
+			),
+		},
+
+		"data_factory_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: factories.ValidateFactoryID,
+		},
+	}
+}
+
+func (d IntegrationRuntimeSelfHostedDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"self_contained_interactive_authoring_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"node": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"machine_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d IntegrationRuntimeSelfHostedDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model IntegrationRuntimeSelfHostedDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			client := metadata.Client.DataFactory.IntegrationRuntimesClient
+
+			dataFactoryId, err := factories.ParseFactoryID(model.DataFactoryID)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewIntegrationRuntimeID(subscriptionId, dataFactoryId.ResourceGroupName, dataFactoryId.FactoryName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			selfHosted, ok := existing.Properties.AsSelfHostedIntegrationRuntime()
+			if !ok {
+				return fmt.Errorf("converting Integration Runtime to Self-Hosted %s", id)
+			}
+
+			status, err := client.GetStatus(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving status for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			model.DataFactoryID = dataFactoryId.ID()
+			model.Description = pointer.From(selfHosted.Description)
+
+			if props := selfHosted.SelfHostedIntegrationRuntimeTypeProperties; props != nil {
+				model.SelfContainedInteractiveAuthoringEnabled = pointer.From(props.SelfContainedInteractiveAuthoringEnabled)
+			}
+
+			model.Node = make([]IntegrationRuntimeSelfHostedNode, 0)
+			if status.Properties != nil {
+				if selfHostedStatus, ok := status.Properties.AsSelfHostedIntegrationRuntimeStatus(); ok && selfHostedStatus.SelfHostedIntegrationRuntimeStatusTypeProperties != nil && selfHostedStatus.Nodes != nil {
+					for _, node := range *selfHostedStatus.Nodes {
+						model.Node = append(model.Node, IntegrationRuntimeSelfHostedNode{
+							Name:        pointer.From(node.NodeName),
+							MachineName: pointer.From(node.MachineName),
+							Status:      string(node.Status),
+							Version:     pointer.From(node.Version),
+						})
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
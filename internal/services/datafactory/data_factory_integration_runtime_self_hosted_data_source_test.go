@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DataFactoryIntegrationRuntimeSelfHostedDataSource struct{}
+
+func TestAccDataFactoryIntegrationRuntimeSelfHostedDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_data_factory_integration_runtime_self_hosted", "test")
+	r := DataFactoryIntegrationRuntimeSelfHostedDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("node.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (DataFactoryIntegrationRuntimeSelfHostedDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_data_factory_integration_runtime_self_hosted" "test" {
+  name            = azurerm_data_factory_integration_runtime_self_hosted.test.name
+  data_factory_id = azurerm_data_factory.test.id
+}
+`, IntegrationRuntimeSelfHostedResource{}.basic(data))
+}
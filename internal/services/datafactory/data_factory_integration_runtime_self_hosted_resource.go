@@ -5,6 +5,7 @@ package datafactory
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -83,6 +84,18 @@ func resourceDataFactoryIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"primary_authorization_key_rotation_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"secondary_authorization_key_rotation_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"primary_authorization_key": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -92,6 +105,34 @@ func resourceDataFactoryIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"node": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"machine_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -147,6 +188,21 @@ func resourceDataFactoryIntegrationRuntimeSelfHostedCreateUpdate(d *pluginsdk.Re
 		return fmt.Errorf("creating/updating Data Factory Self-Hosted %s: %+v", id, err)
 	}
 
+	// the authorization keys are generated alongside the Integration Runtime, so they only need to be rotated on update
+	if !d.IsNewResource() {
+		if d.HasChange("primary_authorization_key_rotation_trigger") {
+			if err := regenerateDataFactoryIntegrationRuntimeSelfHostedAuthKey(ctx, client, id, datafactory.IntegrationRuntimeAuthKeyNameAuthKey1); err != nil {
+				return err
+			}
+		}
+
+		if d.HasChange("secondary_authorization_key_rotation_trigger") {
+			if err := regenerateDataFactoryIntegrationRuntimeSelfHostedAuthKey(ctx, client, id, datafactory.IntegrationRuntimeAuthKeyNameAuthKey2); err != nil {
+				return err
+			}
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceDataFactoryIntegrationRuntimeSelfHostedRead(d, meta)
@@ -187,6 +243,21 @@ func resourceDataFactoryIntegrationRuntimeSelfHostedRead(d *pluginsdk.ResourceDa
 		d.Set("description", selfHostedIntegrationRuntime.Description)
 	}
 
+	statusResp, err := client.GetStatus(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving status for %s: %+v", *id, err)
+	}
+
+	var nodes *[]datafactory.SelfHostedIntegrationRuntimeNode
+	if statusResp.Properties != nil {
+		if status, ok := statusResp.Properties.AsSelfHostedIntegrationRuntimeStatus(); ok && status.SelfHostedIntegrationRuntimeStatusTypeProperties != nil {
+			nodes = status.SelfHostedIntegrationRuntimeStatusTypeProperties.Nodes
+		}
+	}
+	if err := d.Set("node", flattenDataFactoryIntegrationRuntimeSelfHostedNodes(nodes)); err != nil {
+		return fmt.Errorf("setting `node`: %+v", err)
+	}
+
 	if props := selfHostedIntegrationRuntime.SelfHostedIntegrationRuntimeTypeProperties; props != nil {
 		d.Set("self_contained_interactive_authoring_enabled", pointer.From(props.SelfContainedInteractiveAuthoringEnabled))
 		// LinkedInfo BasicLinkedIntegrationRuntimeType
@@ -239,6 +310,35 @@ func resourceDataFactoryIntegrationRuntimeSelfHostedDelete(d *pluginsdk.Resource
 	return nil
 }
 
+func regenerateDataFactoryIntegrationRuntimeSelfHostedAuthKey(ctx context.Context, client *datafactory.IntegrationRuntimesClient, id parse.IntegrationRuntimeId, keyName datafactory.IntegrationRuntimeAuthKeyName) error {
+	parameters := datafactory.IntegrationRuntimeRegenerateKeyParameters{
+		KeyName: keyName,
+	}
+	if _, err := client.RegenerateAuthKey(ctx, id.ResourceGroup, id.FactoryName, id.Name, parameters); err != nil {
+		return fmt.Errorf("regenerating %q Auth Key for Data Factory Self-Hosted %s: %+v", string(keyName), id, err)
+	}
+
+	return nil
+}
+
+func flattenDataFactoryIntegrationRuntimeSelfHostedNodes(input *[]datafactory.SelfHostedIntegrationRuntimeNode) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, node := range *input {
+		results = append(results, map[string]interface{}{
+			"name":         pointer.From(node.NodeName),
+			"machine_name": pointer.From(node.MachineName),
+			"status":       string(node.Status),
+			"version":      pointer.From(node.Version),
+		})
+	}
+
+	return results
+}
+
 func expandAzureRmDataFactoryIntegrationRuntimeSelfHostedTypePropertiesLinkedInfo(input []interface{}) *datafactory.LinkedIntegrationRuntimeRbacAuthorization {
 	if len(input) == 0 {
 		return nil
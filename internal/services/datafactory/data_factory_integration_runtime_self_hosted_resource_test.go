@@ -77,6 +77,35 @@ func TestAccDataFactoryIntegrationRuntimeSelfHosted_selfContainedInteractiveAuth
 	})
 }
 
+func TestAccDataFactoryIntegrationRuntimeSelfHosted_authorizationKeyRotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_self_hosted", "test")
+	r := IntegrationRuntimeSelfHostedResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.authorizationKeyRotation(data, "first", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("primary_authorization_key_rotation_trigger", "secondary_authorization_key_rotation_trigger"),
+		{
+			Config: r.authorizationKeyRotation(data, "second", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("primary_authorization_key_rotation_trigger", "secondary_authorization_key_rotation_trigger"),
+	})
+}
+
 func TestAccDataFactoryIntegrationRuntimeSelfHosted_rbac(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_self_hosted", "target")
 	r := IntegrationRuntimeSelfHostedResource{}
@@ -117,6 +146,33 @@ resource "azurerm_data_factory_integration_runtime_self_hosted" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (IntegrationRuntimeSelfHostedResource) authorizationKeyRotation(data acceptance.TestData, primaryTrigger, secondaryTrigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirsh%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_integration_runtime_self_hosted" "test" {
+  name            = "acctestSIR%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  primary_authorization_key_rotation_trigger   = "%s"
+  secondary_authorization_key_rotation_trigger = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, primaryTrigger, secondaryTrigger)
+}
+
 func (IntegrationRuntimeSelfHostedResource) selfContainedInteractiveAuthoringEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -4,6 +4,7 @@
 package datafactory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -97,13 +98,42 @@ func resourceDataFactoryLinkedCustomService() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": {
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"parameters"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(possibleValuesForLinkedServiceParameterType(), false),
+						},
+
+						"default_value": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressLinkedServiceParameterDefaultValueDiff,
+						},
+					},
+				},
+				Set: hashLinkedServiceTypedParameter,
+			},
+
 			"annotations": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -120,9 +150,19 @@ func resourceDataFactoryLinkedCustomService() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceDataFactoryLinkedCustomServiceCustomizeDiff),
 	}
 }
 
+func resourceDataFactoryLinkedCustomServiceCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if _, err := expandLinkedServiceTypedParameters(d.Get("parameter").(*pluginsdk.Set).List()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func resourceDataFactoryLinkedCustomServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -165,6 +205,14 @@ func resourceDataFactoryLinkedCustomServiceCreateUpdate(d *pluginsdk.ResourceDat
 		props["parameters"] = expandLinkedServiceParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandLinkedServiceTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		props["parameters"] = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		props["annotations"] = v.([]interface{})
 	}
@@ -263,8 +311,21 @@ func resourceDataFactoryLinkedCustomServiceRead(d *pluginsdk.ResourceData, meta
 		}
 		delete(m, "parameters")
 	}
-	if err := d.Set("parameters", flattenLinkedServiceParameters(parameters)); err != nil {
-		return fmt.Errorf("setting `parameters`: %+v", err)
+	// the typed `parameter` block is used when it's configured, or when the parameters can't be represented as strings
+	if _, ok := d.GetOk("parameter"); ok || !linkedServiceParametersAreStrings(parameters) {
+		typedParameters, err := flattenLinkedServiceTypedParameters(parameters)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("parameter", typedParameters); err != nil {
+			return fmt.Errorf("setting `parameter`: %+v", err)
+		}
+		d.Set("parameters", map[string]interface{}{})
+	} else {
+		if err := d.Set("parameters", flattenLinkedServiceParameters(parameters)); err != nil {
+			return fmt.Errorf("setting `parameters`: %+v", err)
+		}
+		d.Set("parameter", []interface{}{})
 	}
 
 	var integrationRuntime *datafactory.IntegrationRuntimeReference
@@ -122,6 +122,22 @@ func TestAccDataFactoryLinkedCustomService_search(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryLinkedCustomService_typedParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_custom_service", "test")
+	r := LinkedCustomServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.typedParameters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("type_properties_json"),
+	})
+}
+
 func (t LinkedCustomServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LinkedServiceID(state.ID)
 	if err != nil {
@@ -207,6 +223,58 @@ JSON
 `, r.template(data), data.RandomInteger)
 }
 
+func (r LinkedCustomServiceResource) typedParameters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_custom_service" "test" {
+  name                 = "acctestls%d"
+  data_factory_id      = azurerm_data_factory.test.id
+  type                 = "Web"
+  type_properties_json = <<JSON
+{
+  "authenticationType": "Anonymous",
+  "url": "@{concat('https://', linkedService().host, ':', linkedService().port)}"
+}
+JSON
+
+  parameter {
+    name          = "host"
+    type          = "String"
+    default_value = "www.bing.com"
+  }
+
+  parameter {
+    name          = "port"
+    type          = "Int"
+    default_value = "443"
+  }
+
+  parameter {
+    name          = "enabled"
+    type          = "Bool"
+    default_value = "true"
+  }
+
+  parameter {
+    name          = "paths"
+    type          = "Array"
+    default_value = "[ \"a\", \"b\" ]"
+  }
+
+  parameter {
+    name          = "headers"
+    type          = "Object"
+    default_value = <<JSON
+{
+  "accept": "application/json"
+}
+JSON
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r LinkedCustomServiceResource) web(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -63,8 +63,8 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"service_principal_key", "service_principal_id", "storage_account_key", "tenant"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"service_principal_key", "service_principal_id", "storage_account_key", "tenant", "credential_name"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity", "credential_name"},
 			},
 
 			"service_principal_id": {
@@ -72,8 +72,8 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				Optional:      true,
 				ValidateFunc:  validation.IsUUID,
 				RequiredWith:  []string{"service_principal_key", "tenant"},
-				ConflictsWith: []string{"storage_account_key", "use_managed_identity"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"storage_account_key", "use_managed_identity", "credential_name"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity", "credential_name"},
 			},
 
 			"service_principal_key": {
@@ -81,15 +81,15 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				Optional:      true,
 				ValidateFunc:  validation.StringIsNotEmpty,
 				RequiredWith:  []string{"service_principal_id", "tenant"},
-				ConflictsWith: []string{"storage_account_key", "use_managed_identity"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"storage_account_key", "use_managed_identity", "credential_name"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity", "credential_name"},
 			},
 
 			"storage_account_key": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"service_principal_id", "service_principal_key", "use_managed_identity", "tenant"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"service_principal_id", "service_principal_key", "use_managed_identity", "tenant", "credential_name"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity", "credential_name"},
 			},
 
 			"tenant": {
@@ -97,8 +97,16 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				Optional:      true,
 				ValidateFunc:  validation.StringIsNotEmpty,
 				RequiredWith:  []string{"service_principal_id", "service_principal_key"},
-				ConflictsWith: []string{"storage_account_key", "use_managed_identity"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"storage_account_key", "use_managed_identity", "credential_name"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity", "credential_name"},
+			},
+
+			"credential_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"service_principal_id", "service_principal_key", "storage_account_key", "tenant", "use_managed_identity"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity", "credential_name"},
 			},
 
 			"description": {
@@ -172,6 +180,14 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2CreateUpdate(d *pluginsd
 		datalakeStorageGen2Properties = &datafactory.AzureBlobFSLinkedServiceTypeProperties{
 			URL: utils.String(d.Get("url").(string)),
 		}
+	} else if v, ok := d.GetOk("credential_name"); ok {
+		datalakeStorageGen2Properties = &datafactory.AzureBlobFSLinkedServiceTypeProperties{
+			URL: utils.String(d.Get("url").(string)),
+			Credential: &datafactory.CredentialReference{
+				ReferenceName: utils.String(v.(string)),
+				Type:          utils.String("CredentialReference"),
+			},
+		}
 	} else if v, ok := d.GetOk("storage_account_key"); ok {
 		datalakeStorageGen2Properties = &datafactory.AzureBlobFSLinkedServiceTypeProperties{
 			URL: utils.String(d.Get("url").(string)),
@@ -270,6 +286,12 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2Read(d *pluginsdk.Resour
 		d.Set("use_managed_identity", false)
 	}
 
+	credentialName := ""
+	if credential := dataLakeStorageGen2.Credential; credential != nil && credential.ReferenceName != nil {
+		credentialName = *credential.ReferenceName
+	}
+	d.Set("credential_name", credentialName)
+
 	if dataLakeStorageGen2.URL != nil {
 		d.Set("url", dataLakeStorageGen2.URL)
 	}
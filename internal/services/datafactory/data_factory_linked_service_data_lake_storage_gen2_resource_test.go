@@ -63,6 +63,22 @@ func TestAccDataFactoryLinkedServiceDataLakeStorageGen2_managed_id(t *testing.T)
 	})
 }
 
+func TestAccDataFactoryLinkedServiceDataLakeStorageGen2_credential(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_data_lake_storage_gen2", "test")
+	r := LinkedServiceDataLakeStorageGen2Resource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.credential(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDataFactoryLinkedServiceDataLakeStorageGen2_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_data_lake_storage_gen2", "test")
 	r := LinkedServiceDataLakeStorageGen2Resource{}
@@ -205,6 +221,19 @@ resource "azurerm_data_factory_linked_service_data_lake_storage_gen2" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (LinkedServiceDataLakeStorageGen2Resource) credential(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_service_data_lake_storage_gen2" "test" {
+  name            = "acctestDataLake%d"
+  data_factory_id = azurerm_data_factory.test.id
+  credential_name = azurerm_data_factory_credential_service_principal.test.name
+  url             = "https://test.azure.com"
+}
+`, CredentialServicePrincipalResource{}.basic(data), data.RandomInteger)
+}
+
 func (LinkedServiceDataLakeStorageGen2Resource) update1(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -9,13 +9,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/managedprivateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privatelinkservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -81,6 +85,18 @@ func resourceDataFactoryManagedPrivateEndpoint() *pluginsdk.Resource {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+
+			"connection_auto_approval_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -88,6 +104,8 @@ func resourceDataFactoryManagedPrivateEndpoint() *pluginsdk.Resource {
 func resourceDataFactoryManagedPrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DataFactory.ManagedPrivateEndpoints
 	managedVirtualNetworksClient := meta.(*clients.Client).DataFactory.ManagedVirtualNetworks
+	resourcesClient := azuresdkhacks.ResourcesClient{OriginalClient: meta.(*clients.Client).DataFactory.Resources}
+	providersClient := meta.(*clients.Client).Resource.ResourceProvidersClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -136,6 +154,15 @@ func resourceDataFactoryManagedPrivateEndpointCreate(d *pluginsdk.ResourceData,
 		}
 	}
 
+	// this is determined before the Managed Private Endpoint is created, so that unsupported target resources are rejected up front
+	connectionApiVersion := ""
+	if d.Get("connection_auto_approval_enabled").(bool) {
+		connectionApiVersion, err = managedPrivateEndpointConnectionApiVersion(ctx, providersClient, targetResourceId)
+		if err != nil {
+			return err
+		}
+	}
+
 	payload := managedprivateendpoints.ManagedPrivateEndpointResource{
 		Properties: managedprivateendpoints.ManagedPrivateEndpoint{
 			PrivateLinkResourceId: utils.String(targetResourceId),
@@ -167,6 +194,12 @@ func resourceDataFactoryManagedPrivateEndpointCreate(d *pluginsdk.ResourceData,
 
 	d.SetId(id.ID())
 
+	if d.Get("connection_auto_approval_enabled").(bool) {
+		if err := approveManagedPrivateEndpointConnection(ctx, resourcesClient, client, id, targetResourceId, connectionApiVersion); err != nil {
+			return err
+		}
+	}
+
 	return resourceDataFactoryManagedPrivateEndpointRead(d, meta)
 }
 
@@ -199,6 +232,12 @@ func resourceDataFactoryManagedPrivateEndpointRead(d *pluginsdk.ResourceData, me
 		d.Set("target_resource_id", props.PrivateLinkResourceId)
 		d.Set("subresource_name", props.GroupId)
 		d.Set("fqdns", utils.FlattenStringSlice(props.Fqdns))
+
+		connectionStatus := ""
+		if props.ConnectionState != nil && props.ConnectionState.Status != nil {
+			connectionStatus = *props.ConnectionState.Status
+		}
+		d.Set("connection_status", connectionStatus)
 	}
 
 	return nil
@@ -259,3 +298,157 @@ func getManagedPrivateEndpointProvisionStatus(ctx context.Context, client *manag
 		return resp, *resp.Model.Properties.ProvisioningState, nil
 	}
 }
+
+// managedPrivateEndpointConnectionApiVersion determines the API Version used to approve the Private Endpoint Connection on
+// the target resource, since these are exposed by each Resource Provider using its own API Versions. The latest stable
+// API Version of the `privateEndpointConnections` Resource Type is used, falling back to that of the target resource.
+func managedPrivateEndpointConnectionApiVersion(ctx context.Context, client *providers.ProvidersClient, targetResourceId string) (string, error) {
+	parsed, err := azure.ParseAzureResourceID(targetResourceId)
+	if err != nil {
+		return "", err
+	}
+
+	resourceType, err := managedPrivateEndpointTargetResourceType(targetResourceId)
+	if err != nil {
+		return "", err
+	}
+
+	providerId := providers.NewSubscriptionProviderID(parsed.SubscriptionID, parsed.Provider)
+	resp, err := client.Get(ctx, providerId, providers.DefaultGetOperationOptions())
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", providerId, err)
+	}
+
+	resourceTypes := make([]providers.ProviderResourceType, 0)
+	if model := resp.Model; model != nil && model.ResourceTypes != nil {
+		resourceTypes = *model.ResourceTypes
+	}
+
+	for _, candidate := range []string{resourceType + "/privateEndpointConnections", resourceType} {
+		for _, item := range resourceTypes {
+			if item.ResourceType == nil || !strings.EqualFold(*item.ResourceType, candidate) {
+				continue
+			}
+
+			for _, apiVersion := range pointer.From(item.ApiVersions) {
+				if !strings.Contains(strings.ToLower(apiVersion), "preview") {
+					return apiVersion, nil
+				}
+			}
+		}
+	}
+
+	return "", fmt.Errorf("`connection_auto_approval_enabled` is not supported for %q since %s doesn't expose a stable API Version for the Resource Type %q", targetResourceId, providerId, resourceType)
+}
+
+// managedPrivateEndpointTargetResourceType returns the Resource Type of the target resource without the Resource Provider,
+// e.g. `storageAccounts` for `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}`
+func managedPrivateEndpointTargetResourceType(targetResourceId string) (string, error) {
+	segments := strings.Split(strings.Trim(targetResourceId, "/"), "/")
+
+	providerIndex := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			providerIndex = i
+		}
+	}
+	if providerIndex == -1 || len(segments) < providerIndex+4 {
+		return "", fmt.Errorf("unable to determine the Resource Type of %q", targetResourceId)
+	}
+
+	types := make([]string, 0)
+	for i := providerIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return strings.Join(types, "/"), nil
+}
+
+// approveManagedPrivateEndpointConnection approves the pending Private Endpoint Connection on the target resource which
+// was created for the Managed Private Endpoint, the underlying Private Endpoint is named `{factoryName}.{managedPrivateEndpointName}`
+func approveManagedPrivateEndpointConnection(ctx context.Context, resourcesClient azuresdkhacks.ResourcesClient, client *managedprivateendpoints.ManagedPrivateEndpointsClient, id managedprivateendpoints.ManagedPrivateEndpointId, targetResourceId string, apiVersion string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	// the Private Endpoint Connection is created on the target resource asynchronously, so it may not exist yet
+	connectionConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"NotFound"},
+		Target:     []string{"Found"},
+		Refresh:    managedPrivateEndpointConnectionRefreshFunc(ctx, resourcesClient, id, targetResourceId, apiVersion),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	result, err := connectionConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for the Private Endpoint Connection for %s to exist on %q: %+v", id, targetResourceId, err)
+	}
+	connectionId := result.(string)
+
+	var properties interface{} = map[string]interface{}{
+		"privateLinkServiceConnectionState": map[string]interface{}{
+			"status":      "Approved",
+			"description": fmt.Sprintf("Approved by Terraform for Data Factory Managed Private Endpoint %q", id.ManagedPrivateEndpointName),
+		},
+	}
+	payload := resources.GenericResource{
+		Properties: &properties,
+	}
+
+	if err := resourcesClient.CreateOrUpdateByIdThenPoll(ctx, connectionId, apiVersion, payload); err != nil {
+		return fmt.Errorf("approving the Private Endpoint Connection %q for %s: %+v", connectionId, id, err)
+	}
+
+	// the approval is then propagated back to the Managed Private Endpoint asynchronously
+	statusConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"", "Pending"},
+		Target:     []string{"Approved"},
+		Refresh:    getManagedPrivateEndpointConnectionStatus(ctx, client, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := statusConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the connection of %s to be `Approved`: %+v", id, err)
+	}
+
+	return nil
+}
+
+func managedPrivateEndpointConnectionRefreshFunc(ctx context.Context, client azuresdkhacks.ResourcesClient, id managedprivateendpoints.ManagedPrivateEndpointId, targetResourceId string, apiVersion string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.ListPrivateEndpointConnections(ctx, targetResourceId, apiVersion)
+		if err != nil {
+			return nil, "", fmt.Errorf("listing the Private Endpoint Connections for %q: %+v", targetResourceId, err)
+		}
+
+		privateEndpointName := fmt.Sprintf("%s.%s", id.FactoryName, id.ManagedPrivateEndpointName)
+		for _, connection := range pointer.From(resp.Model) {
+			if connection.Id == nil || connection.Properties == nil || connection.Properties.PrivateEndpoint == nil || connection.Properties.PrivateEndpoint.Id == nil {
+				continue
+			}
+
+			if strings.HasSuffix(strings.ToLower(*connection.Properties.PrivateEndpoint.Id), "/privateendpoints/"+strings.ToLower(privateEndpointName)) {
+				return *connection.Id, "Found", nil
+			}
+		}
+
+		return "", "NotFound", nil
+	}
+}
+
+func getManagedPrivateEndpointConnectionStatus(ctx context.Context, client *managedprivateendpoints.ManagedPrivateEndpointsClient, id managedprivateendpoints.ManagedPrivateEndpointId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id, managedprivateendpoints.DefaultGetOperationOptions())
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		status := ""
+		if model := resp.Model; model != nil && model.Properties.ConnectionState != nil && model.Properties.ConnectionState.Status != nil {
+			status = *model.Properties.ConnectionState.Status
+		}
+
+		return resp, status, nil
+	}
+}
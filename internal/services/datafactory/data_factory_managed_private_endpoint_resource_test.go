@@ -63,6 +63,23 @@ func TestAccDataFactoryManagedPrivateEndpoint_privateServiceLink(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryManagedPrivateEndpoint_connectionAutoApproval(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_managed_private_endpoint", "test")
+	r := ManagedPrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connectionAutoApproval(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// Create waits for the approval to be propagated back to the Managed Private Endpoint
+				check.That(data.ResourceName).Key("connection_status").HasValue("Approved"),
+			),
+		},
+		data.ImportStep("connection_auto_approval_enabled"),
+	})
+}
+
 func (r ManagedPrivateEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := managedprivateendpoints.ParseManagedPrivateEndpointID(state.ID)
 	if err != nil {
@@ -107,6 +124,30 @@ resource "azurerm_data_factory_managed_private_endpoint" "test" {
 `, template, data.RandomString, data.RandomInteger)
 }
 
+func (r ManagedPrivateEndpointResource) connectionAutoApproval(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+	%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "BlobStorage"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_data_factory_managed_private_endpoint" "test" {
+  name                             = "acctestEndpoint%d"
+  data_factory_id                  = azurerm_data_factory.test.id
+  target_resource_id               = azurerm_storage_account.test.id
+  subresource_name                 = "blob"
+  connection_auto_approval_enabled = true
+}
+`, template, data.RandomString, data.RandomInteger)
+}
+
 func (r ManagedPrivateEndpointResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
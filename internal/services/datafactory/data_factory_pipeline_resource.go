@@ -4,6 +4,7 @@
 package datafactory
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -81,6 +82,225 @@ func resourceDataFactoryPipeline() *pluginsdk.Resource {
 				Optional:         true,
 				StateFunc:        utils.NormalizeJson,
 				DiffSuppressFunc: suppressJsonOrderingDifference,
+				ConflictsWith:    []string{"activity"},
+			},
+
+			"activity": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"activities_json"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"depends_on": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"activity_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"conditions": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(datafactory.DependencyConditionCompleted),
+												string(datafactory.DependencyConditionFailed),
+												string(datafactory.DependencyConditionSkipped),
+												string(datafactory.DependencyConditionSucceeded),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"copy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"input_dataset_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"output_dataset_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"source_json": {
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										StateFunc:        utils.NormalizeJson,
+										DiffSuppressFunc: suppressJsonOrderingDifference,
+										ValidateFunc:     validation.StringIsJSON,
+									},
+
+									"sink_json": {
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										StateFunc:        utils.NormalizeJson,
+										DiffSuppressFunc: suppressJsonOrderingDifference,
+										ValidateFunc:     validation.StringIsJSON,
+									},
+								},
+							},
+						},
+
+						"execute_pipeline": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"pipeline_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+									},
+
+									"parameters": {
+										Type:     pluginsdk.TypeMap,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"wait_on_completion": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+
+						"for_each": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"items": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"activities_json": {
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										StateFunc:        utils.NormalizeJson,
+										DiffSuppressFunc: suppressJsonOrderingDifference,
+										ValidateFunc:     validation.StringIsJSON,
+									},
+
+									"sequential_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"batch_count": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 50),
+									},
+								},
+							},
+						},
+
+						"lookup": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"dataset_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"source_json": {
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										StateFunc:        utils.NormalizeJson,
+										DiffSuppressFunc: suppressJsonOrderingDifference,
+										ValidateFunc:     validation.StringIsJSON,
+									},
+
+									"first_row_only": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+
+						"web": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"method": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(datafactory.WebActivityMethodDELETE),
+											string(datafactory.WebActivityMethodGET),
+											string(datafactory.WebActivityMethodPOST),
+											string(datafactory.WebActivityMethodPUT),
+										}, false),
+									},
+
+									"url": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"headers": {
+										Type:     pluginsdk.TypeMap,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"body": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 
 			"annotations": {
@@ -154,6 +374,19 @@ func resourceDataFactoryPipelineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		pipeline.Activities = activities
 	}
 
+	if v, ok := d.GetOk("activity"); ok {
+		activitiesJson, err := expandDataFactoryPipelineActivities(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `activity` for Data Factory %s: %+v", id, err)
+		}
+
+		activities, err := deserializeDataFactoryPipelineActivities(activitiesJson)
+		if err != nil {
+			return fmt.Errorf("parsing `activity` for Data Factory %s: %+v", id, err)
+		}
+		pipeline.Activities = activities
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations := v.([]interface{})
 		pipeline.Annotations = &annotations
@@ -265,8 +498,20 @@ func resourceDataFactoryPipelineRead(d *pluginsdk.ResourceData, meta interface{}
 			if err != nil {
 				return fmt.Errorf("serializing `activities_json`: %+v", err)
 			}
-			if err := d.Set("activities_json", activitiesJson); err != nil {
-				return fmt.Errorf("setting `activities_json`: %+v", err)
+
+			// the structured `activity` blocks and `activities_json` are mutually exclusive, so only one is populated
+			if _, ok := d.GetOk("activity"); ok {
+				activity, err := flattenDataFactoryPipelineActivities(activitiesJson)
+				if err != nil {
+					return fmt.Errorf("flattening `activity`: %+v", err)
+				}
+				if err := d.Set("activity", activity); err != nil {
+					return fmt.Errorf("setting `activity`: %+v", err)
+				}
+			} else {
+				if err := d.Set("activities_json", activitiesJson); err != nil {
+					return fmt.Errorf("setting `activities_json`: %+v", err)
+				}
 			}
 		}
 	}
@@ -290,3 +535,329 @@ func resourceDataFactoryPipelineDelete(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+// expandDataFactoryPipelineActivities builds the JSON representation of the structured `activity` blocks, so that it can
+// be deserialized using the same (polymorphic) unmarshaler as `activities_json`
+func expandDataFactoryPipelineActivities(input []interface{}) (string, error) {
+	activities := make([]interface{}, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+		name := raw["name"].(string)
+
+		dependsOn := make([]interface{}, 0)
+		for _, v := range raw["depends_on"].([]interface{}) {
+			if v == nil {
+				continue
+			}
+			dependency := v.(map[string]interface{})
+			dependsOn = append(dependsOn, map[string]interface{}{
+				"activity":             dependency["activity_name"].(string),
+				"dependencyConditions": dependency["conditions"].([]interface{}),
+			})
+		}
+
+		activity := map[string]interface{}{
+			"name":           name,
+			"dependsOn":      dependsOn,
+			"userProperties": []interface{}{},
+		}
+		if description := raw["description"].(string); description != "" {
+			activity["description"] = description
+		}
+
+		typesSpecified := 0
+
+		if v := raw["copy"].([]interface{}); len(v) > 0 && v[0] != nil {
+			typesSpecified++
+			copyActivity := v[0].(map[string]interface{})
+
+			var source, sink interface{}
+			if err := json.Unmarshal([]byte(copyActivity["source_json"].(string)), &source); err != nil {
+				return "", fmt.Errorf("parsing `source_json` for activity %q: %+v", name, err)
+			}
+			if err := json.Unmarshal([]byte(copyActivity["sink_json"].(string)), &sink); err != nil {
+				return "", fmt.Errorf("parsing `sink_json` for activity %q: %+v", name, err)
+			}
+
+			activity["type"] = "Copy"
+			activity["inputs"] = []interface{}{expandDataFactoryPipelineActivityDatasetReference(copyActivity["input_dataset_name"].(string))}
+			activity["outputs"] = []interface{}{expandDataFactoryPipelineActivityDatasetReference(copyActivity["output_dataset_name"].(string))}
+			activity["typeProperties"] = map[string]interface{}{
+				"source": source,
+				"sink":   sink,
+			}
+		}
+
+		if v := raw["execute_pipeline"].([]interface{}); len(v) > 0 && v[0] != nil {
+			typesSpecified++
+			executePipeline := v[0].(map[string]interface{})
+
+			activity["type"] = "ExecutePipeline"
+			activity["typeProperties"] = map[string]interface{}{
+				"pipeline": map[string]interface{}{
+					"referenceName": executePipeline["pipeline_name"].(string),
+					"type":          "PipelineReference",
+				},
+				"parameters":       executePipeline["parameters"].(map[string]interface{}),
+				"waitOnCompletion": executePipeline["wait_on_completion"].(bool),
+			}
+		}
+
+		if v := raw["for_each"].([]interface{}); len(v) > 0 && v[0] != nil {
+			typesSpecified++
+			forEach := v[0].(map[string]interface{})
+
+			var innerActivities interface{}
+			if err := json.Unmarshal([]byte(forEach["activities_json"].(string)), &innerActivities); err != nil {
+				return "", fmt.Errorf("parsing `activities_json` for activity %q: %+v", name, err)
+			}
+
+			typeProperties := map[string]interface{}{
+				"items": map[string]interface{}{
+					"value": forEach["items"].(string),
+					"type":  "Expression",
+				},
+				"isSequential": forEach["sequential_enabled"].(bool),
+				"activities":   innerActivities,
+			}
+			if batchCount := forEach["batch_count"].(int); batchCount > 0 {
+				typeProperties["batchCount"] = batchCount
+			}
+
+			activity["type"] = "ForEach"
+			activity["typeProperties"] = typeProperties
+		}
+
+		if v := raw["lookup"].([]interface{}); len(v) > 0 && v[0] != nil {
+			typesSpecified++
+			lookup := v[0].(map[string]interface{})
+
+			var source interface{}
+			if err := json.Unmarshal([]byte(lookup["source_json"].(string)), &source); err != nil {
+				return "", fmt.Errorf("parsing `source_json` for activity %q: %+v", name, err)
+			}
+
+			activity["type"] = "Lookup"
+			activity["typeProperties"] = map[string]interface{}{
+				"source":       source,
+				"dataset":      expandDataFactoryPipelineActivityDatasetReference(lookup["dataset_name"].(string)),
+				"firstRowOnly": lookup["first_row_only"].(bool),
+			}
+		}
+
+		if v := raw["web"].([]interface{}); len(v) > 0 && v[0] != nil {
+			typesSpecified++
+			web := v[0].(map[string]interface{})
+
+			typeProperties := map[string]interface{}{
+				"method": web["method"].(string),
+				"url":    web["url"].(string),
+			}
+			if headers := web["headers"].(map[string]interface{}); len(headers) > 0 {
+				typeProperties["headers"] = headers
+			}
+			if body := web["body"].(string); body != "" {
+				typeProperties["body"] = body
+			}
+
+			activity["type"] = "WebActivity"
+			activity["typeProperties"] = typeProperties
+		}
+
+		if typesSpecified != 1 {
+			return "", fmt.Errorf("exactly one of `copy`, `execute_pipeline`, `for_each`, `lookup` or `web` must be specified for activity %q", name)
+		}
+
+		activities = append(activities, activity)
+	}
+
+	result, err := json.Marshal(activities)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+func expandDataFactoryPipelineActivityDatasetReference(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"referenceName": name,
+		"type":          "DatasetReference",
+	}
+}
+
+func flattenDataFactoryPipelineActivities(activitiesJson string) ([]interface{}, error) {
+	var activities []map[string]interface{}
+	if err := json.Unmarshal([]byte(activitiesJson), &activities); err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, 0)
+	for _, activity := range activities {
+		name, _ := activity["name"].(string)
+		description, _ := activity["description"].(string)
+		activityType, _ := activity["type"].(string)
+		typeProperties, _ := activity["typeProperties"].(map[string]interface{})
+
+		dependsOn := make([]interface{}, 0)
+		if v, ok := activity["dependsOn"].([]interface{}); ok {
+			for _, item := range v {
+				dependency, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				activityName, _ := dependency["activity"].(string)
+				conditions, _ := dependency["dependencyConditions"].([]interface{})
+				dependsOn = append(dependsOn, map[string]interface{}{
+					"activity_name": activityName,
+					"conditions":    conditions,
+				})
+			}
+		}
+
+		result := map[string]interface{}{
+			"name":             name,
+			"description":      description,
+			"depends_on":       dependsOn,
+			"copy":             []interface{}{},
+			"execute_pipeline": []interface{}{},
+			"for_each":         []interface{}{},
+			"lookup":           []interface{}{},
+			"web":              []interface{}{},
+		}
+
+		switch activityType {
+		case "Copy":
+			source, err := json.Marshal(typeProperties["source"])
+			if err != nil {
+				return nil, fmt.Errorf("serializing `source_json` for activity %q: %+v", name, err)
+			}
+			sink, err := json.Marshal(typeProperties["sink"])
+			if err != nil {
+				return nil, fmt.Errorf("serializing `sink_json` for activity %q: %+v", name, err)
+			}
+
+			result["copy"] = []interface{}{
+				map[string]interface{}{
+					"input_dataset_name":  flattenDataFactoryPipelineActivityDatasetReferences(activity["inputs"]),
+					"output_dataset_name": flattenDataFactoryPipelineActivityDatasetReferences(activity["outputs"]),
+					"source_json":         string(source),
+					"sink_json":           string(sink),
+				},
+			}
+
+		case "ExecutePipeline":
+			pipelineName := ""
+			if pipeline, ok := typeProperties["pipeline"].(map[string]interface{}); ok {
+				pipelineName, _ = pipeline["referenceName"].(string)
+			}
+			parameters := make(map[string]interface{})
+			if v, ok := typeProperties["parameters"].(map[string]interface{}); ok {
+				for key, value := range v {
+					parameters[key] = fmt.Sprintf("%v", value)
+				}
+			}
+			waitOnCompletion := false
+			if v, ok := typeProperties["waitOnCompletion"].(bool); ok {
+				waitOnCompletion = v
+			}
+
+			result["execute_pipeline"] = []interface{}{
+				map[string]interface{}{
+					"pipeline_name":      pipelineName,
+					"parameters":         parameters,
+					"wait_on_completion": waitOnCompletion,
+				},
+			}
+
+		case "ForEach":
+			items := ""
+			if v, ok := typeProperties["items"].(map[string]interface{}); ok {
+				items, _ = v["value"].(string)
+			}
+			innerActivities, err := json.Marshal(typeProperties["activities"])
+			if err != nil {
+				return nil, fmt.Errorf("serializing `activities_json` for activity %q: %+v", name, err)
+			}
+			sequentialEnabled, _ := typeProperties["isSequential"].(bool)
+			batchCount := 0
+			if v, ok := typeProperties["batchCount"].(float64); ok {
+				batchCount = int(v)
+			}
+
+			result["for_each"] = []interface{}{
+				map[string]interface{}{
+					"items":              items,
+					"activities_json":    string(innerActivities),
+					"sequential_enabled": sequentialEnabled,
+					"batch_count":        batchCount,
+				},
+			}
+
+		case "Lookup":
+			source, err := json.Marshal(typeProperties["source"])
+			if err != nil {
+				return nil, fmt.Errorf("serializing `source_json` for activity %q: %+v", name, err)
+			}
+			datasetName := ""
+			if v, ok := typeProperties["dataset"].(map[string]interface{}); ok {
+				datasetName, _ = v["referenceName"].(string)
+			}
+			firstRowOnly := false
+			if v, ok := typeProperties["firstRowOnly"].(bool); ok {
+				firstRowOnly = v
+			}
+
+			result["lookup"] = []interface{}{
+				map[string]interface{}{
+					"dataset_name":   datasetName,
+					"source_json":    string(source),
+					"first_row_only": firstRowOnly,
+				},
+			}
+
+		case "WebActivity":
+			method, _ := typeProperties["method"].(string)
+			url, _ := typeProperties["url"].(string)
+			body, _ := typeProperties["body"].(string)
+			headers := make(map[string]interface{})
+			if v, ok := typeProperties["headers"].(map[string]interface{}); ok {
+				for key, value := range v {
+					headers[key] = fmt.Sprintf("%v", value)
+				}
+			}
+
+			result["web"] = []interface{}{
+				map[string]interface{}{
+					"method":  method,
+					"url":     url,
+					"headers": headers,
+					"body":    body,
+				},
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func flattenDataFactoryPipelineActivityDatasetReferences(input interface{}) string {
+	references, ok := input.([]interface{})
+	if !ok || len(references) == 0 {
+		return ""
+	}
+
+	if reference, ok := references[0].(map[string]interface{}); ok {
+		if name, ok := reference["referenceName"].(string); ok {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -113,6 +113,22 @@ func TestAccDataFactoryPipeline_activities(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryPipeline_activityBlocks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_pipeline", "test")
+	r := PipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.activityBlocks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("activity", "activities_json"),
+	})
+}
+
 func (t PipelineResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.PipelineID(state.ID)
 	if err != nil {
@@ -290,6 +306,61 @@ JSON
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (PipelineResource) activityBlocks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_pipeline" "child" {
+  name            = "acctestchild%d"
+  data_factory_id = azurerm_data_factory.test.id
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name            = "acctest%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  activity {
+    name = "web"
+
+    web {
+      method = "GET"
+      url    = "https://example.com"
+      headers = {
+        "Content-Type" = "application/json"
+      }
+    }
+  }
+
+  activity {
+    name        = "execute"
+    description = "runs the child pipeline"
+
+    depends_on {
+      activity_name = "web"
+      conditions    = ["Succeeded"]
+    }
+
+    execute_pipeline {
+      pipeline_name = azurerm_data_factory_pipeline.child.name
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (PipelineResource) webActivityHeaders(data acceptance.TestData, withHeader bool) string {
 	headerBlock := `
       "headers": {
@@ -321,6 +321,19 @@ func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	// the repository configuration is managed separately from the factory, so when it's been removed from the config
+	// we need to explicitly disconnect the repository
+	if !d.IsNewResource() && githubConfiguration == nil && vstsConfiguration == nil && d.HasChanges("github_configuration", "vsts_configuration") {
+		repoUpdate := factories.FactoryRepoUpdate{
+			FactoryResourceId: utils.String(id.ID()),
+			RepoConfiguration: nil,
+		}
+		locationId := factories.NewLocationID(id.SubscriptionId, location)
+		if _, err := client.ConfigureFactoryRepo(ctx, locationId, repoUpdate); err != nil {
+			return fmt.Errorf("removing Repository for %s: %+v", id, err)
+		}
+	}
+
 	if d.Get("managed_virtual_network_enabled").(bool) {
 		networkPayload := managedvirtualnetworks.ManagedVirtualNetworkResource{
 			Properties: managedvirtualnetworks.ManagedVirtualNetwork{},
@@ -3,7 +3,13 @@
 
 package datafactory
 
-import "testing"
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tombuildsstuff/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
+)
 
 func TestDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 	cases := []struct {
@@ -42,6 +48,50 @@ func TestDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 	}
 }
 
+func TestDataFactoryLinkedServiceParameterDefaultValueDiff(t *testing.T) {
+	cases := []struct {
+		Type   string
+		Old    string
+		New    string
+		NoDiff bool
+	}{
+		{
+			Type:   "Array",
+			Old:    `["a","b"]`,
+			New:    `[ "a", "b" ]`,
+			NoDiff: true,
+		},
+		{
+			Type:   "Object",
+			Old:    `{"a":1,"b":"c"}`,
+			New:    "{\n  \"b\": \"c\",\n  \"a\": 1\n}\n",
+			NoDiff: true,
+		},
+		{
+			Type:   "Object",
+			Old:    `{"a":1}`,
+			New:    `{"a":2}`,
+			NoDiff: false,
+		},
+		{
+			Type:   "String",
+			Old:    `["a","b"]`,
+			New:    `[ "a", "b" ]`,
+			NoDiff: false,
+		},
+	}
+
+	for _, tc := range cases {
+		old := map[string]interface{}{"name": "test", "type": tc.Type, "default_value": tc.Old}
+		new := map[string]interface{}{"name": "test", "type": tc.Type, "default_value": tc.New}
+		noDiff := hashLinkedServiceTypedParameter(old) == hashLinkedServiceTypedParameter(new)
+
+		if noDiff != tc.NoDiff {
+			t.Fatalf("Expected the hashes of the %s parameters %q and %q to match to be '%t' - got '%t'", tc.Type, tc.Old, tc.New, tc.NoDiff, noDiff)
+		}
+	}
+}
+
 func TestDataFactoryDeserializePipelineActivities(t *testing.T) {
 	cases := []struct {
 		Json                string
@@ -206,3 +256,183 @@ func TestNormalizeJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestDataFactoryLinkedServiceTypedParameters(t *testing.T) {
+	cases := []struct {
+		Type         string
+		DefaultValue string
+		ShouldError  bool
+	}{
+		{
+			Type:         "String",
+			DefaultValue: "hello",
+		},
+		{
+			Type:         "Int",
+			DefaultValue: "443",
+		},
+		{
+			Type:         "Int",
+			DefaultValue: "not-a-number",
+			ShouldError:  true,
+		},
+		{
+			Type:         "Bool",
+			DefaultValue: "true",
+		},
+		{
+			Type:         "Float",
+			DefaultValue: "1.5",
+		},
+		{
+			Type:         "Array",
+			DefaultValue: `["a","b"]`,
+		},
+		{
+			Type:         "Array",
+			DefaultValue: `{"a":"b"}`,
+			ShouldError:  true,
+		},
+		{
+			Type:         "Object",
+			DefaultValue: `{"a":"b"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		input := []interface{}{
+			map[string]interface{}{
+				"name":          "test",
+				"type":          tc.Type,
+				"default_value": tc.DefaultValue,
+			},
+		}
+
+		expanded, err := expandLinkedServiceTypedParameters(input)
+		if err != nil {
+			if !tc.ShouldError {
+				t.Fatalf("expanding %s %q: %+v", tc.Type, tc.DefaultValue, err)
+			}
+			continue
+		}
+		if tc.ShouldError {
+			t.Fatalf("expected an error expanding %s %q", tc.Type, tc.DefaultValue)
+		}
+
+		// round-trip via JSON as the API would
+		bytes, err := json.Marshal(expanded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var returned map[string]*datafactory.ParameterSpecification
+		if err := json.Unmarshal(bytes, &returned); err != nil {
+			t.Fatal(err)
+		}
+
+		flattened, err := flattenLinkedServiceTypedParameters(returned)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(flattened, input) {
+			t.Fatalf("Expected %+v - got %+v", input, flattened)
+		}
+	}
+}
+
+func TestDataFactoryExpandFlattenPipelineActivities(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":             "lookup",
+			"description":      "",
+			"depends_on":       []interface{}{},
+			"copy":             []interface{}{},
+			"execute_pipeline": []interface{}{},
+			"for_each":         []interface{}{},
+			"lookup": []interface{}{
+				map[string]interface{}{
+					"dataset_name":   "dataset1",
+					"source_json":    `{"type":"AzureSqlSource"}`,
+					"first_row_only": false,
+				},
+			},
+			"web": []interface{}{},
+		},
+		map[string]interface{}{
+			"name":        "execute",
+			"description": "runs the child pipeline",
+			"depends_on": []interface{}{
+				map[string]interface{}{
+					"activity_name": "lookup",
+					"conditions":    []interface{}{"Succeeded"},
+				},
+			},
+			"copy": []interface{}{},
+			"execute_pipeline": []interface{}{
+				map[string]interface{}{
+					"pipeline_name":      "child",
+					"parameters":         map[string]interface{}{"foo": "bar"},
+					"wait_on_completion": true,
+				},
+			},
+			"for_each": []interface{}{},
+			"lookup":   []interface{}{},
+			"web":      []interface{}{},
+		},
+	}
+
+	activitiesJson, err := expandDataFactoryPipelineActivities(input)
+	if err != nil {
+		t.Fatalf("expanding activities: %+v", err)
+	}
+
+	activities, err := deserializeDataFactoryPipelineActivities(activitiesJson)
+	if err != nil {
+		t.Fatalf("deserializing activities: %+v", err)
+	}
+	if len(*activities) != 2 {
+		t.Fatalf("expected 2 activities but got %d", len(*activities))
+	}
+
+	serialized, err := serializeDataFactoryPipelineActivities(activities)
+	if err != nil {
+		t.Fatalf("serializing activities: %+v", err)
+	}
+
+	output, err := flattenDataFactoryPipelineActivities(serialized)
+	if err != nil {
+		t.Fatalf("flattening activities: %+v", err)
+	}
+	if len(output) != 2 {
+		t.Fatalf("expected 2 flattened activities but got %d", len(output))
+	}
+
+	lookup := output[0].(map[string]interface{})["lookup"].([]interface{})[0].(map[string]interface{})
+	if lookup["dataset_name"] != "dataset1" || lookup["first_row_only"] != false {
+		t.Fatalf("unexpected `lookup` block: %+v", lookup)
+	}
+
+	execute := output[1].(map[string]interface{})
+	if execute["description"] != "runs the child pipeline" {
+		t.Fatalf("expected description %q but got %q", "runs the child pipeline", execute["description"])
+	}
+	executePipeline := execute["execute_pipeline"].([]interface{})[0].(map[string]interface{})
+	if executePipeline["pipeline_name"] != "child" || executePipeline["parameters"].(map[string]interface{})["foo"] != "bar" {
+		t.Fatalf("unexpected `execute_pipeline` block: %+v", executePipeline)
+	}
+	dependsOn := execute["depends_on"].([]interface{})[0].(map[string]interface{})
+	if dependsOn["activity_name"] != "lookup" {
+		t.Fatalf("expected dependency on %q but got %q", "lookup", dependsOn["activity_name"])
+	}
+
+	input[0].(map[string]interface{})["web"] = []interface{}{
+		map[string]interface{}{
+			"method":  "GET",
+			"url":     "https://example.com",
+			"headers": map[string]interface{}{},
+			"body":    "",
+		},
+	}
+	if _, err := expandDataFactoryPipelineActivities(input); err == nil {
+		t.Fatalf("expected an error when multiple activity types are specified")
+	}
+}
@@ -4,6 +4,7 @@
 package datafactory
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -322,14 +323,11 @@ func resourceDataFactoryTriggerScheduleCreate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if d.Get("activated").(bool) {
-		future, err := client.Start(ctx, id.ResourceGroup, id.FactoryName, id.Name)
-		if err != nil {
-			return fmt.Errorf("starting %s: %+v", id, err)
-		}
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting on start %s: %+v", id, err)
-		}
+	// the trigger is left stopped when opted out via the `start_triggers_on_create` feature, it's then started
+	// during the next apply (e.g. once the pipelines have been published)
+	activated := d.Get("activated").(bool) && meta.(*clients.Client).Features.DataFactory.StartTriggersOnCreate
+	if err := dataFactoryTriggerSetRuntimeState(ctx, client, id, false, activated); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID())
@@ -347,18 +345,32 @@ func resourceDataFactoryTriggerScheduleUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	_, err = client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	// activated triggers cannot be updated - we activate the trigger again after updating
-	future, err := client.Stop(ctx, id.ResourceGroup, id.FactoryName, id.Name)
-	if err != nil {
-		return fmt.Errorf("stopping %s: %+v", *id, err)
+	started := false
+	if existing.Properties != nil {
+		if scheduleTrigger, ok := existing.Properties.AsScheduleTrigger(); ok && scheduleTrigger != nil {
+			started = scheduleTrigger.RuntimeState == datafactory.TriggerRuntimeStateStarted
+		}
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting to stop %s: %+v", id, err)
+
+	activated := d.Get("activated").(bool)
+
+	// only the runtime state has changed, so the trigger doesn't need to be stopped and updated
+	if !d.HasChangesExcept("activated") {
+		if err := dataFactoryTriggerSetRuntimeState(ctx, client, *id, started, activated); err != nil {
+			return err
+		}
+
+		return resourceDataFactoryTriggerScheduleRead(d, meta)
+	}
+
+	// activated triggers cannot be updated - we activate the trigger again after updating
+	if err := dataFactoryTriggerSetRuntimeState(ctx, client, *id, started, false); err != nil {
+		return err
 	}
 
 	props := &datafactory.ScheduleTriggerTypeProperties{
@@ -414,17 +426,11 @@ func resourceDataFactoryTriggerScheduleUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, trigger, ""); err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
+		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
-	if d.Get("activated").(bool) {
-		future, err := client.Start(ctx, id.ResourceGroup, id.FactoryName, id.Name)
-		if err != nil {
-			return fmt.Errorf("starting %s: %+v", id, err)
-		}
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting on start %s: %+v", id, err)
-		}
+	if err := dataFactoryTriggerSetRuntimeState(ctx, client, *id, false, activated); err != nil {
+		return err
 	}
 
 	return resourceDataFactoryTriggerScheduleRead(d, meta)
@@ -641,3 +647,31 @@ func flattenDataFactoryPipelines(pipelines *[]datafactory.TriggerPipelineReferen
 
 	return &res
 }
+
+func dataFactoryTriggerSetRuntimeState(ctx context.Context, client *datafactory.TriggersClient, id parse.TriggerId, started bool, activated bool) error {
+	if started == activated {
+		return nil
+	}
+
+	if activated {
+		future, err := client.Start(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+		if err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on start %s: %+v", id, err)
+		}
+
+		return nil
+	}
+
+	future, err := client.Stop(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting to stop %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -70,6 +70,39 @@ func TestAccDataFactoryTriggerSchedule_complete(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryTriggerSchedule_stoppedOnCreate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_trigger_schedule", "test")
+	r := TriggerScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the trigger is left stopped on create and then started during the next apply
+			Config: r.stoppedOnCreate(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activated").HasValue("false"),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.stoppedOnCreate(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activated").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stoppedOnCreate(data, "activated = false"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activated").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDataFactoryTriggerSchedule_scheduleWeekly(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_trigger_schedule", "test")
 	r := TriggerScheduleResource{}
@@ -150,6 +183,41 @@ resource "azurerm_data_factory_trigger_schedule" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (TriggerScheduleResource) stoppedOnCreate(data acceptance.TestData, activated string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    data_factory {
+      start_triggers_on_create = false
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name            = "acctest%[1]d"
+  data_factory_id = azurerm_data_factory.test.id
+}
+
+resource "azurerm_data_factory_trigger_schedule" "test" {
+  name            = "acctestdf%[1]d"
+  data_factory_id = azurerm_data_factory.test.id
+  pipeline_name   = azurerm_data_factory_pipeline.test.name
+  %[3]s
+}
+`, data.RandomInteger, data.Locations.Primary, activated)
+}
+
 func (TriggerScheduleResource) pipeline(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
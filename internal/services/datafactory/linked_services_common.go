@@ -4,8 +4,13 @@
 package datafactory
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/tombuildsstuff/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
@@ -39,3 +44,162 @@ func flattenLinkedServiceParameters(input map[string]*datafactory.ParameterSpeci
 
 	return output
 }
+
+func possibleValuesForLinkedServiceParameterType() []string {
+	output := make([]string, 0)
+	for _, v := range datafactory.PossibleParameterTypeValues() {
+		output = append(output, string(v))
+	}
+	return output
+}
+
+func expandLinkedServiceTypedParameters(input []interface{}) (map[string]*datafactory.ParameterSpecification, error) {
+	output := make(map[string]*datafactory.ParameterSpecification)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		name := v["name"].(string)
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("duplicate parameter name %q", name)
+		}
+
+		parameterType := datafactory.ParameterType(v["type"].(string))
+		defaultValue, err := expandLinkedServiceParameterDefaultValue(parameterType, v["default_value"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `default_value` for the parameter %q: %+v", name, err)
+		}
+
+		output[name] = &datafactory.ParameterSpecification{
+			Type:         parameterType,
+			DefaultValue: defaultValue,
+		}
+	}
+
+	return output, nil
+}
+
+func expandLinkedServiceParameterDefaultValue(parameterType datafactory.ParameterType, input string) (interface{}, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	switch parameterType {
+	case datafactory.ParameterTypeBool:
+		return strconv.ParseBool(input)
+	case datafactory.ParameterTypeInt:
+		return strconv.ParseInt(input, 10, 64)
+	case datafactory.ParameterTypeFloat:
+		return strconv.ParseFloat(input, 64)
+	case datafactory.ParameterTypeArray:
+		var output []interface{}
+		if err := json.Unmarshal([]byte(input), &output); err != nil {
+			return nil, fmt.Errorf("expected a JSON array: %+v", err)
+		}
+		return output, nil
+	case datafactory.ParameterTypeObject:
+		var output map[string]interface{}
+		if err := json.Unmarshal([]byte(input), &output); err != nil {
+			return nil, fmt.Errorf("expected a JSON object: %+v", err)
+		}
+		return output, nil
+	}
+
+	return input, nil
+}
+
+func flattenLinkedServiceTypedParameters(input map[string]*datafactory.ParameterSpecification) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+
+		defaultValue := ""
+		switch value := v.DefaultValue.(type) {
+		case nil:
+		case string:
+			defaultValue = value
+		case bool:
+			defaultValue = strconv.FormatBool(value)
+		case float64:
+			defaultValue = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			bytes, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("marshalling the default value for the parameter %q: %+v", k, err)
+			}
+			defaultValue = string(bytes)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":          k,
+			"type":          string(v.Type),
+			"default_value": defaultValue,
+		})
+	}
+
+	return output, nil
+}
+
+// normalizeLinkedServiceParameterDefaultValue returns the compact form of Array and Object default values, since the
+// API returns these as compact JSON which would otherwise differ from the formatting used in the configuration
+func normalizeLinkedServiceParameterDefaultValue(parameterType string, input string) string {
+	if t := datafactory.ParameterType(parameterType); t != datafactory.ParameterTypeArray && t != datafactory.ParameterTypeObject {
+		return input
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		return input
+	}
+
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return input
+	}
+
+	return string(bytes)
+}
+
+func suppressLinkedServiceParameterDefaultValueDiff(k, old, new string, d *pluginsdk.ResourceData) bool {
+	parameterType := d.Get(strings.TrimSuffix(k, "default_value") + "type").(string)
+	return normalizeLinkedServiceParameterDefaultValue(parameterType, old) == normalizeLinkedServiceParameterDefaultValue(parameterType, new)
+}
+
+// hashLinkedServiceTypedParameter hashes the normalized default value, so that equivalent JSON values are the same element of the set
+func hashLinkedServiceTypedParameter(v interface{}) int {
+	raw, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	parameterType, _ := raw["type"].(string)
+	defaultValue, _ := raw["default_value"].(string)
+	name, _ := raw["name"].(string)
+
+	return pluginsdk.HashString(fmt.Sprintf("%s-%s-%s", name, parameterType, normalizeLinkedServiceParameterDefaultValue(parameterType, defaultValue)))
+}
+
+// linkedServiceParametersAreStrings returns whether the parameters can be represented by the untyped `parameters` map
+func linkedServiceParametersAreStrings(input map[string]*datafactory.ParameterSpecification) bool {
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		if v.Type != "" && v.Type != datafactory.ParameterTypeString {
+			return false
+		}
+
+		if _, ok := v.DefaultValue.(string); !ok && v.DefaultValue != nil {
+			return false
+		}
+	}
+
+	return true
+}
@@ -34,6 +34,7 @@ func (Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		TriggerScheduleDataSource{},
 		TriggerSchedulesDataSource{},
+		IntegrationRuntimeSelfHostedDataSource{},
 	}
 }
 
@@ -12,10 +12,12 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -87,6 +89,23 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(backupvaults.PossibleValuesForSoftDeleteState(), false),
 			},
 
+			"cross_region_restore_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"immutability": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(backupvaults.ImmutabilityStateDisabled),
+				ValidateFunc: validation.StringInSlice(backupvaults.PossibleValuesForImmutabilityState(), false),
+			},
+
+			"secondary_location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 
@@ -94,6 +113,22 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("soft_delete", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) == string(backupvaults.SoftDeleteStateAlwaysOn) && new.(string) != string(backupvaults.SoftDeleteStateAlwaysOn)
 			}),
+			// Cross Region Restore can't be disabled once enabled
+			pluginsdk.ForceNewIfChange("cross_region_restore_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if diff.Get("cross_region_restore_enabled").(bool) && diff.Get("redundancy").(string) != string(backupvaults.StorageSettingTypesGeoRedundant) {
+					return fmt.Errorf("`cross_region_restore_enabled` can only be set when `redundancy` is `%s`", backupvaults.StorageSettingTypesGeoRedundant)
+				}
+
+				// a Locked immutability policy is irreversible, whereas Disabled and Unlocked can be switched between freely
+				if old, new := diff.GetChange("immutability"); old.(string) == string(backupvaults.ImmutabilityStateLocked) && new.(string) != string(backupvaults.ImmutabilityStateLocked) {
+					return fmt.Errorf("`immutability` cannot be changed once it has been set to `%s`", backupvaults.ImmutabilityStateLocked)
+				}
+
+				return nil
+			},
 		),
 	}
 
@@ -169,12 +204,23 @@ func resourceDataProtectionBackupVaultCreateUpdate(d *pluginsdk.ResourceData, me
 				SoftDeleteSettings: &backupvaults.SoftDeleteSettings{
 					State: pointer.To(backupvaults.SoftDeleteState(d.Get("soft_delete").(string))),
 				},
+				ImmutabilitySettings: &backupvaults.ImmutabilitySettings{
+					State: pointer.To(backupvaults.ImmutabilityState(d.Get("immutability").(string))),
+				},
 			},
 		},
 		Identity: expandedIdentity,
 		Tags:     expandTags(d.Get("tags").(map[string]interface{})),
 	}
 
+	if d.Get("cross_region_restore_enabled").(bool) {
+		parameters.Properties.FeatureSettings = &backupvaults.FeatureSettings{
+			CrossRegionRestoreSettings: &backupvaults.CrossRegionRestoreSettings{
+				State: pointer.To(backupvaults.CrossRegionRestoreStateEnabled),
+			},
+		}
+	}
+
 	if v, ok := d.GetOk("retention_duration_in_days"); ok {
 		parameters.Properties.SecuritySettings.SoftDeleteSettings.RetentionDurationInDays = pointer.To(v.(float64))
 	}
@@ -217,12 +263,36 @@ func resourceDataProtectionBackupVaultRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("datastore_type", string(pointer.From((props.StorageSettings)[0].DatastoreType)))
 			d.Set("redundancy", string(pointer.From((props.StorageSettings)[0].Type)))
 		}
+		immutability := backupvaults.ImmutabilityStateDisabled
 		if securitySetting := model.Properties.SecuritySettings; securitySetting != nil {
 			if softDelete := securitySetting.SoftDeleteSettings; softDelete != nil {
 				d.Set("soft_delete", string(pointer.From(softDelete.State)))
 				d.Set("retention_duration_in_days", pointer.From(softDelete.RetentionDurationInDays))
 			}
+			if settings := securitySetting.ImmutabilitySettings; settings != nil && settings.State != nil {
+				immutability = *settings.State
+			}
 		}
+		d.Set("immutability", string(immutability))
+
+		crossRegionRestoreEnabled := false
+		if featureSettings := props.FeatureSettings; featureSettings != nil && featureSettings.CrossRegionRestoreSettings != nil {
+			crossRegionRestoreEnabled = pointer.From(featureSettings.CrossRegionRestoreSettings.State) == backupvaults.CrossRegionRestoreStateEnabled
+		}
+		d.Set("cross_region_restore_enabled", crossRegionRestoreEnabled)
+
+		// restores from a Geo Redundant vault are made into the Azure paired region, since both the location and the
+		// redundancy are ForceNew this is only looked up when it's not already known, and on a best-effort basis
+		secondaryLocation := ""
+		if d.Get("redundancy").(string) == string(backupvaults.StorageSettingTypesGeoRedundant) {
+			secondaryLocation = d.Get("secondary_location").(string)
+			if secondaryLocation == "" {
+				if secondaryLocation, err = findBackupVaultSecondaryLocation(ctx, meta.(*clients.Client), location.NormalizeNilable(model.Location)); err != nil {
+					log.Printf("[WARN] determining the secondary location for %s: %+v", id, err)
+				}
+			}
+		}
+		d.Set("secondary_location", secondaryLocation)
 
 		if err = d.Set("identity", flattenBackupVaultDppIdentityDetails(model.Identity)); err != nil {
 			return fmt.Errorf("setting `identity`: %+v", err)
@@ -254,6 +324,30 @@ func resourceDataProtectionBackupVaultDelete(d *pluginsdk.ResourceData, meta int
 	return nil
 }
 
+func findBackupVaultSecondaryLocation(ctx context.Context, client *clients.Client, vaultLocation string) (string, error) {
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	resp, err := client.Subscription.SubscriptionsClient.ListLocations(ctx, subscriptionId, subscriptions.DefaultListLocationsOperationOptions())
+	if err != nil {
+		return "", fmt.Errorf("listing locations for %s: %+v", subscriptionId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Value != nil {
+		for _, item := range *model.Value {
+			if location.Normalize(pointer.From(item.Name)) != vaultLocation || item.Metadata == nil || item.Metadata.PairedRegion == nil {
+				continue
+			}
+
+			for _, paired := range *item.Metadata.PairedRegion {
+				if name := pointer.From(paired.Name); name != "" {
+					return location.Normalize(name), nil
+				}
+			}
+		}
+	}
+
+	return "", nil
+}
+
 func expandBackupVaultDppIdentityDetails(input []interface{}) (*backupvaults.DppIdentityDetails, error) {
 	config, err := identity.ExpandSystemAssigned(input)
 	if err != nil {
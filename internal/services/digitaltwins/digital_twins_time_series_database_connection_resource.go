@@ -8,10 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/timeseriesdatabaseconnections"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
@@ -33,6 +34,11 @@ type TimeSeriesDatabaseConnectionModel struct {
 	KustoClusterUri              string `tfschema:"kusto_cluster_uri"`
 	KustoDatabaseName            string `tfschema:"kusto_database_name"`
 	KustoTableName               string `tfschema:"kusto_table_name"`
+
+	KustoRelationshipLifecycleEventsTableName string `tfschema:"kusto_relationship_lifecycle_events_table_name"`
+	KustoTwinLifecycleEventsTableName         string `tfschema:"kusto_twin_lifecycle_events_table_name"`
+	RecordPropertyAndItemRemovalsEnabled      bool   `tfschema:"record_property_and_item_removals_enabled"`
+	UserAssignedIdentityId                    string `tfschema:"user_assigned_identity_id"`
 }
 
 type TimeSeriesDatabaseConnectionResource struct{}
@@ -110,6 +116,34 @@ func (m TimeSeriesDatabaseConnectionResource) Arguments() map[string]*pluginsdk.
 			ForceNew:     true,
 			ValidateFunc: kustoValidate.EntityName,
 		},
+
+		"kusto_relationship_lifecycle_events_table_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: kustoValidate.EntityName,
+		},
+
+		"kusto_twin_lifecycle_events_table_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: kustoValidate.EntityName,
+		},
+
+		"record_property_and_item_removals_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
 	}
 
 	if !features.FourPointOhBeta() {
@@ -184,6 +218,25 @@ func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 				properties.EventHubConsumerGroup = utils.String(model.EventhubConsumerGroupName)
 			}
 
+			if model.KustoRelationshipLifecycleEventsTableName != "" {
+				properties.AdxRelationshipLifecycleEventsTableName = pointer.To(model.KustoRelationshipLifecycleEventsTableName)
+			}
+
+			if model.KustoTwinLifecycleEventsTableName != "" {
+				properties.AdxTwinLifecycleEventsTableName = pointer.To(model.KustoTwinLifecycleEventsTableName)
+			}
+
+			if model.RecordPropertyAndItemRemovalsEnabled {
+				properties.RecordPropertyAndItemRemovals = pointer.To(true)
+			}
+
+			if model.UserAssignedIdentityId != "" {
+				properties.Identity = &timeseriesdatabaseconnections.ManagedIdentityReference{
+					Type:                 pointer.To(timeseriesdatabaseconnections.IdentityTypeUserAssigned),
+					UserAssignedIdentity: pointer.To(model.UserAssignedIdentityId),
+				}
+			}
+
 			req := timeseriesdatabaseconnections.TimeSeriesDatabaseConnection{
 				Properties: properties,
 			}
@@ -249,6 +302,18 @@ func (m TimeSeriesDatabaseConnectionResource) Read() sdk.ResourceFunc {
 					kustoTableName = *properties.AdxTableName
 				}
 				output.KustoTableName = kustoTableName
+
+				output.KustoRelationshipLifecycleEventsTableName = pointer.From(properties.AdxRelationshipLifecycleEventsTableName)
+				output.KustoTwinLifecycleEventsTableName = pointer.From(properties.AdxTwinLifecycleEventsTableName)
+				output.RecordPropertyAndItemRemovalsEnabled = pointer.From(properties.RecordPropertyAndItemRemovals)
+
+				if identity := properties.Identity; identity != nil && pointer.From(identity.Type) == timeseriesdatabaseconnections.IdentityTypeUserAssigned && identity.UserAssignedIdentity != nil {
+					userAssignedIdentityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(*identity.UserAssignedIdentity)
+					if err != nil {
+						return fmt.Errorf("parsing `user_assigned_identity_id`: %+v", err)
+					}
+					output.UserAssignedIdentityId = userAssignedIdentityId.ID()
+				}
 			}
 
 			return meta.Encode(&output)
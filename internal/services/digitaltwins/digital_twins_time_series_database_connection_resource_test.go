@@ -48,6 +48,20 @@ func TestAccTimeSeriesDatabaseConnection_complete(t *testing.T) {
 	})
 }
 
+func TestAccTimeSeriesDatabaseConnection_userAssignedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_digital_twins_time_series_database_connection", "test")
+	r := TimeSeriesDatabaseConnectionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.userAssignedIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r TimeSeriesDatabaseConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := timeseriesdatabaseconnections.ParseTimeSeriesDatabaseConnectionID(state.ID)
 	if err != nil {
@@ -104,8 +118,11 @@ resource "azurerm_digital_twins_time_series_database_connection" "test" {
   kusto_cluster_uri               = azurerm_kusto_cluster.test.uri
   kusto_database_name             = azurerm_kusto_database.test.name
 
-  eventhub_consumer_group_name = azurerm_eventhub_consumer_group.test.name
-  kusto_table_name             = "mytable"
+  eventhub_consumer_group_name                   = azurerm_eventhub_consumer_group.test.name
+  kusto_table_name                               = "mytable"
+  kusto_relationship_lifecycle_events_table_name = "myrelationshiptable"
+  kusto_twin_lifecycle_events_table_name         = "mytwintable"
+  record_property_and_item_removals_enabled      = true
 
   depends_on = [
     azurerm_role_assignment.database_contributor,
@@ -116,6 +133,111 @@ resource "azurerm_digital_twins_time_series_database_connection" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r TimeSeriesDatabaseConnectionResource) userAssignedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-digitaltwin-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_digital_twins_instance" "test" {
+  name                = "acctest-DT-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[2]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 7
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.test.name
+}
+
+resource "azurerm_role_assignment" "database_contributor" {
+  scope                = azurerm_kusto_database.test.id
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+  role_definition_name = "Contributor"
+}
+
+resource "azurerm_role_assignment" "eventhub_data_owner" {
+  scope                = azurerm_eventhub.test.id
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+  role_definition_name = "Azure Event Hubs Data Owner"
+}
+
+resource "azurerm_kusto_database_principal_assignment" "test" {
+  name                = "acctestkdpa%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_name        = azurerm_kusto_cluster.test.name
+  database_name       = azurerm_kusto_database.test.name
+
+  tenant_id      = azurerm_user_assigned_identity.test.tenant_id
+  principal_id   = azurerm_user_assigned_identity.test.principal_id
+  principal_type = "App"
+  role           = "Admin"
+}
+
+resource "azurerm_digital_twins_time_series_database_connection" "test" {
+  name                            = "connection-%[2]d"
+  digital_twins_id                = azurerm_digital_twins_instance.test.id
+  eventhub_name                   = azurerm_eventhub.test.name
+  eventhub_namespace_id           = azurerm_eventhub_namespace.test.id
+  eventhub_namespace_endpoint_uri = "sb://${azurerm_eventhub_namespace.test.name}.servicebus.windows.net"
+  kusto_cluster_id                = azurerm_kusto_cluster.test.id
+  kusto_cluster_uri               = azurerm_kusto_cluster.test.uri
+  kusto_database_name             = azurerm_kusto_database.test.name
+  user_assigned_identity_id       = azurerm_user_assigned_identity.test.id
+
+  depends_on = [
+    azurerm_role_assignment.database_contributor,
+    azurerm_role_assignment.eventhub_data_owner,
+    azurerm_kusto_database_principal_assignment.test
+  ]
+}
+`, data.Locations.Primary, data.RandomInteger, data.RandomString)
+}
+
 func (r TimeSeriesDatabaseConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
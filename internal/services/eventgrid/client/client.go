@@ -7,16 +7,79 @@ import (
 	"fmt"
 
 	eventgrid_v2022_06_15 "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clientgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespacetopics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/permissionbindings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/topicspaces"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
-func NewClient(o *common.ClientOptions) (*eventgrid_v2022_06_15.Client, error) {
-	client, err := eventgrid_v2022_06_15.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+type Client struct {
+	*eventgrid_v2022_06_15.Client
+
+	NamespaceClientGroupsClient       *clientgroups.ClientGroupsClient
+	NamespaceClientsClient            *clients.ClientsClient
+	NamespacePermissionBindingsClient *permissionbindings.PermissionBindingsClient
+	NamespaceTopicSpacesClient        *topicspaces.TopicSpacesClient
+	NamespaceTopicsClient             *namespacetopics.NamespaceTopicsClient
+	NamespacesClient                  *namespaces.NamespacesClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	metaClient, err := eventgrid_v2022_06_15.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("building EventGrid client: %+v", err)
 	}
-	return client, nil
+
+	clientGroupsClient, err := clientgroups.NewClientGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Namespace Client Groups client: %+v", err)
+	}
+	o.Configure(clientGroupsClient.Client, o.Authorizers.ResourceManager)
+
+	clientsClient, err := clients.NewClientsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Namespace Clients client: %+v", err)
+	}
+	o.Configure(clientsClient.Client, o.Authorizers.ResourceManager)
+
+	permissionBindingsClient, err := permissionbindings.NewPermissionBindingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Namespace Permission Bindings client: %+v", err)
+	}
+	o.Configure(permissionBindingsClient.Client, o.Authorizers.ResourceManager)
+
+	topicSpacesClient, err := topicspaces.NewTopicSpacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Namespace Topic Spaces client: %+v", err)
+	}
+	o.Configure(topicSpacesClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceTopicsClient, err := namespacetopics.NewNamespaceTopicsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Namespace Topics client: %+v", err)
+	}
+	o.Configure(namespaceTopicsClient.Client, o.Authorizers.ResourceManager)
+
+	namespacesClient, err := namespaces.NewNamespacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Namespaces client: %+v", err)
+	}
+	o.Configure(namespacesClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		Client: metaClient,
+
+		NamespaceClientGroupsClient:       clientGroupsClient,
+		NamespaceClientsClient:            clientsClient,
+		NamespacePermissionBindingsClient: permissionBindingsClient,
+		NamespaceTopicSpacesClient:        topicSpacesClient,
+		NamespaceTopicsClient:             namespaceTopicsClient,
+		NamespacesClient:                  namespacesClient,
+	}, nil
 }
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceClientResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceClientResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceClientResource{}
+)
+
+type EventGridNamespaceClientModel struct {
+	Name                      string                                         `tfschema:"name"`
+	NamespaceId               string                                         `tfschema:"namespace_id"`
+	AuthenticationName        string                                         `tfschema:"authentication_name"`
+	Attributes                map[string]string                              `tfschema:"attributes"`
+	CertificateAuthentication []EventGridNamespaceClientCertificateAuthModel `tfschema:"certificate_authentication"`
+	Description               string                                         `tfschema:"description"`
+	Enabled                   bool                                           `tfschema:"enabled"`
+}
+
+type EventGridNamespaceClientCertificateAuthModel struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9:-]{3,50}$`),
+				"`name` must be between 3 and 50 characters and may only contain letters, numbers, hyphens and colons",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: clients.ValidateNamespaceID,
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(3, 128),
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice(
+							clients.PossibleValuesForClientCertificateValidationScheme(),
+							false,
+						),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientModel{}
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return clients.ValidateClientID
+}
+
+func (r EventGridNamespaceClientResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model EventGridNamespaceClientModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, auth := range model.CertificateAuthentication {
+				if auth.ValidationScheme == string(clients.ClientCertificateValidationSchemeThumbprintMatch) && len(auth.AllowedThumbprints) == 0 && metadata.ResourceDiff.NewValueKnown("certificate_authentication.0.allowed_thumbprints") {
+					return fmt.Errorf("`allowed_thumbprints` must be specified when `validation_scheme` is `%s`", clients.ClientCertificateValidationSchemeThumbprintMatch)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := clients.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := clients.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			state := clients.ClientStateEnabled
+			if !model.Enabled {
+				state = clients.ClientStateDisabled
+			}
+
+			parameters := clients.Client{
+				Properties: &clients.ClientProperties{
+					Attributes:                      expandEventGridNamespaceClientAttributes(model.Attributes),
+					ClientCertificateAuthentication: expandEventGridNamespaceClientCertificateAuthentication(model.CertificateAuthentication),
+					State:                           pointer.To(state),
+				},
+			}
+
+			if model.AuthenticationName != "" {
+				parameters.Properties.AuthenticationName = pointer.To(model.AuthenticationName)
+			}
+
+			if model.Description != "" {
+				parameters.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientModel{
+				Name:        id.ClientName,
+				NamespaceId: clients.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.Attributes = flattenEventGridNamespaceClientAttributes(props.Attributes)
+					state.CertificateAuthentication = flattenEventGridNamespaceClientCertificateAuthentication(props.ClientCertificateAuthentication)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.State) != clients.ClientStateDisabled
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("attributes") {
+				payload.Properties.Attributes = expandEventGridNamespaceClientAttributes(model.Attributes)
+			}
+
+			if metadata.ResourceData.HasChange("certificate_authentication") {
+				payload.Properties.ClientCertificateAuthentication = expandEventGridNamespaceClientCertificateAuthentication(model.CertificateAuthentication)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				state := clients.ClientStateEnabled
+				if !model.Enabled {
+					state = clients.ClientStateDisabled
+				}
+				payload.Properties.State = pointer.To(state)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceClientAttributes(input map[string]string) *interface{} {
+	attributes := make(map[string]interface{})
+	for k, v := range input {
+		attributes[k] = v
+	}
+
+	var output interface{} = attributes
+	return &output
+}
+
+func flattenEventGridNamespaceClientAttributes(input *interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	if attributes, ok := (*input).(map[string]interface{}); ok {
+		for k, v := range attributes {
+			if s, ok := v.(string); ok {
+				output[k] = s
+			}
+		}
+	}
+	return output
+}
+
+func expandEventGridNamespaceClientCertificateAuthentication(input []EventGridNamespaceClientCertificateAuthModel) *clients.ClientCertificateAuthentication {
+	if len(input) == 0 {
+		return nil
+	}
+
+	auth := input[0]
+	output := clients.ClientCertificateAuthentication{
+		ValidationScheme: pointer.To(clients.ClientCertificateValidationScheme(auth.ValidationScheme)),
+	}
+
+	if len(auth.AllowedThumbprints) > 0 {
+		output.AllowedThumbprints = pointer.To(auth.AllowedThumbprints)
+	}
+
+	return &output
+}
+
+func flattenEventGridNamespaceClientCertificateAuthentication(input *clients.ClientCertificateAuthentication) []EventGridNamespaceClientCertificateAuthModel {
+	if input == nil {
+		return []EventGridNamespaceClientCertificateAuthModel{}
+	}
+
+	return []EventGridNamespaceClientCertificateAuthModel{
+		{
+			ValidationScheme:   string(pointer.From(input.ValidationScheme)),
+			AllowedThumbprints: pointer.From(input.AllowedThumbprints),
+		},
+	}
+}
@@ -0,0 +1,581 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespacetopics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+
+type EventGridNamespaceModel struct {
+	Name                       string                                     `tfschema:"name"`
+	ResourceGroupName          string                                     `tfschema:"resource_group_name"`
+	Location                   string                                     `tfschema:"location"`
+	Sku                        string                                     `tfschema:"sku"`
+	Capacity                   int64                                      `tfschema:"capacity"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	InboundIpRule              []EventGridNamespaceInboundIpRuleModel     `tfschema:"inbound_ip_rule"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	TopicSpacesConfiguration   []EventGridNamespaceTopicSpacesModel       `tfschema:"topic_spaces_configuration"`
+	Tags                       map[string]string                          `tfschema:"tags"`
+}
+
+type EventGridNamespaceInboundIpRuleModel struct {
+	IpMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type EventGridNamespaceTopicSpacesModel struct {
+	AlternativeAuthenticationNameSources       []string                                   `tfschema:"alternative_authentication_name_sources"`
+	MaximumClientSessionsPerAuthenticationName int64                                      `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64                                      `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string                                     `tfschema:"route_topic_id"`
+	DynamicRoutingEnrichment                   []EventGridNamespaceRoutingEnrichmentModel `tfschema:"dynamic_routing_enrichment"`
+	StaticRoutingEnrichment                    []EventGridNamespaceRoutingEnrichmentModel `tfschema:"static_routing_enrichment"`
+	Hostname                                   string                                     `tfschema:"hostname"`
+}
+
+type EventGridNamespaceRoutingEnrichmentModel struct {
+	Key   string `tfschema:"key"`
+	Value string `tfschema:"value"`
+}
+
+func (r EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,48}[a-zA-Z0-9]$`),
+				"`name` must be between 3 and 50 characters, start and end with a letter or number and may only contain letters, numbers and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(namespaces.SkuNameStandard),
+			ValidateFunc: validation.StringInSlice(
+				namespaces.PossibleValuesForSkuName(),
+				false,
+			),
+		},
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"inbound_ip_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 128,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(namespaces.IPActionTypeAllow),
+						ValidateFunc: validation.StringInSlice(
+							namespaces.PossibleValuesForIPActionType(),
+							false,
+						),
+					},
+				},
+			},
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_sources": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(
+								namespaces.PossibleValuesForAlternativeAuthenticationNameSource(),
+								false,
+							),
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+					},
+
+					"dynamic_routing_enrichment": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"key": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"static_routing_enrichment": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"key": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"hostname": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceResource) ModelObject() interface{} {
+	return &EventGridNamespaceModel{}
+}
+
+func (r EventGridNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaces.ValidateNamespaceID
+}
+
+func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := namespaces.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			topicSpacesConfiguration, err := expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration)
+			if err != nil {
+				return err
+			}
+
+			publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+			if !model.PublicNetworkAccessEnabled {
+				publicNetworkAccess = namespaces.PublicNetworkAccessDisabled
+			}
+
+			parameters := namespaces.Namespace{
+				Location: location.Normalize(model.Location),
+				Identity: identityValue,
+				Properties: &namespaces.NamespaceProperties{
+					InboundIPRules:           expandEventGridNamespaceInboundIpRules(model.InboundIpRule),
+					PublicNetworkAccess:      pointer.To(publicNetworkAccess),
+					TopicSpacesConfiguration: topicSpacesConfiguration,
+				},
+				Sku: &namespaces.NamespaceSku{
+					Name:     pointer.To(namespaces.SkuName(model.Sku)),
+					Capacity: pointer.To(model.Capacity),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceModel{
+				Name:              id.NamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				identityValue, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(identityValue)
+
+				if sku := model.Sku; sku != nil {
+					state.Sku = string(pointer.From(sku.Name))
+					state.Capacity = pointer.From(sku.Capacity)
+				}
+
+				if props := model.Properties; props != nil {
+					state.InboundIpRule = flattenEventGridNamespaceInboundIpRules(props.InboundIPRules)
+					state.PublicNetworkAccessEnabled = pointer.From(props.PublicNetworkAccess) != namespaces.PublicNetworkAccessDisabled
+
+					topicSpacesConfiguration, err := flattenEventGridNamespaceTopicSpacesConfiguration(props.TopicSpacesConfiguration)
+					if err != nil {
+						return err
+					}
+					state.TopicSpacesConfiguration = topicSpacesConfiguration
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChanges("sku", "capacity") {
+				payload.Sku = &namespaces.NamespaceSku{
+					Name:     pointer.To(namespaces.SkuName(model.Sku)),
+					Capacity: pointer.To(model.Capacity),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("inbound_ip_rule") {
+				payload.Properties.InboundIPRules = expandEventGridNamespaceInboundIpRules(model.InboundIpRule)
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+				if !model.PublicNetworkAccessEnabled {
+					publicNetworkAccess = namespaces.PublicNetworkAccessDisabled
+				}
+				payload.Properties.PublicNetworkAccess = pointer.To(publicNetworkAccess)
+			}
+
+			if metadata.ResourceData.HasChange("topic_spaces_configuration") {
+				topicSpacesConfiguration, err := expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration)
+				if err != nil {
+					return err
+				}
+				payload.Properties.TopicSpacesConfiguration = topicSpacesConfiguration
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceInboundIpRules(input []EventGridNamespaceInboundIpRuleModel) *[]namespaces.InboundIPRule {
+	output := make([]namespaces.InboundIPRule, 0)
+	for _, v := range input {
+		output = append(output, namespaces.InboundIPRule{
+			IPMask: pointer.To(v.IpMask),
+			Action: pointer.To(namespaces.IPActionType(v.Action)),
+		})
+	}
+	return &output
+}
+
+func flattenEventGridNamespaceInboundIpRules(input *[]namespaces.InboundIPRule) []EventGridNamespaceInboundIpRuleModel {
+	output := make([]EventGridNamespaceInboundIpRuleModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, EventGridNamespaceInboundIpRuleModel{
+			IpMask: pointer.From(v.IPMask),
+			Action: string(pointer.From(v.Action)),
+		})
+	}
+	return output
+}
+
+func expandEventGridNamespaceTopicSpacesConfiguration(input []EventGridNamespaceTopicSpacesModel) (*namespaces.TopicSpacesConfiguration, error) {
+	if len(input) == 0 {
+		return &namespaces.TopicSpacesConfiguration{
+			State: pointer.To(namespaces.TopicSpacesConfigurationStateDisabled),
+		}, nil
+	}
+
+	config := input[0]
+	output := namespaces.TopicSpacesConfiguration{
+		State: pointer.To(namespaces.TopicSpacesConfigurationStateEnabled),
+		MaximumClientSessionsPerAuthenticationName: pointer.To(config.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.To(config.MaximumSessionExpiryInHours),
+	}
+
+	if len(config.AlternativeAuthenticationNameSources) > 0 {
+		sources := make([]namespaces.AlternativeAuthenticationNameSource, 0)
+		for _, v := range config.AlternativeAuthenticationNameSources {
+			sources = append(sources, namespaces.AlternativeAuthenticationNameSource(v))
+		}
+		output.ClientAuthentication = &namespaces.ClientAuthenticationSettings{
+			AlternativeAuthenticationNameSources: &sources,
+		}
+	}
+
+	if config.RouteTopicId != "" {
+		topicId, err := namespacetopics.ParseNamespaceTopicID(config.RouteTopicId)
+		if err != nil {
+			return nil, err
+		}
+		output.RouteTopicResourceId = pointer.To(topicId.ID())
+	}
+
+	dynamicEnrichments := make([]namespaces.DynamicRoutingEnrichment, 0)
+	for _, v := range config.DynamicRoutingEnrichment {
+		dynamicEnrichments = append(dynamicEnrichments, namespaces.DynamicRoutingEnrichment{
+			Key:   pointer.To(v.Key),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	staticEnrichments := make([]namespaces.StaticRoutingEnrichment, 0)
+	for _, v := range config.StaticRoutingEnrichment {
+		staticEnrichments = append(staticEnrichments, namespaces.StaticStringRoutingEnrichment{
+			Key:   pointer.To(v.Key),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	output.RoutingEnrichments = &namespaces.RoutingEnrichments{
+		Dynamic: &dynamicEnrichments,
+		Static:  &staticEnrichments,
+	}
+
+	return &output, nil
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *namespaces.TopicSpacesConfiguration) ([]EventGridNamespaceTopicSpacesModel, error) {
+	if input == nil || pointer.From(input.State) != namespaces.TopicSpacesConfigurationStateEnabled {
+		return []EventGridNamespaceTopicSpacesModel{}, nil
+	}
+
+	output := EventGridNamespaceTopicSpacesModel{
+		MaximumClientSessionsPerAuthenticationName: pointer.From(input.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.From(input.MaximumSessionExpiryInHours),
+		Hostname:                                   pointer.From(input.Hostname),
+	}
+
+	if auth := input.ClientAuthentication; auth != nil && auth.AlternativeAuthenticationNameSources != nil {
+		sources := make([]string, 0)
+		for _, v := range *auth.AlternativeAuthenticationNameSources {
+			sources = append(sources, string(v))
+		}
+		output.AlternativeAuthenticationNameSources = sources
+	}
+
+	if v := pointer.From(input.RouteTopicResourceId); v != "" {
+		topicId, err := namespacetopics.ParseNamespaceTopicIDInsensitively(v)
+		if err != nil {
+			return nil, err
+		}
+		output.RouteTopicId = topicId.ID()
+	}
+
+	if enrichments := input.RoutingEnrichments; enrichments != nil {
+		dynamicEnrichments := make([]EventGridNamespaceRoutingEnrichmentModel, 0)
+		for _, v := range pointer.From(enrichments.Dynamic) {
+			dynamicEnrichments = append(dynamicEnrichments, EventGridNamespaceRoutingEnrichmentModel{
+				Key:   pointer.From(v.Key),
+				Value: pointer.From(v.Value),
+			})
+		}
+		output.DynamicRoutingEnrichment = dynamicEnrichments
+
+		staticEnrichments := make([]EventGridNamespaceRoutingEnrichmentModel, 0)
+		for _, v := range pointer.From(enrichments.Static) {
+			if enrichment, ok := v.(namespaces.StaticStringRoutingEnrichment); ok {
+				staticEnrichments = append(staticEnrichments, EventGridNamespaceRoutingEnrichmentModel{
+					Key:   pointer.From(enrichment.Key),
+					Value: pointer.From(enrichment.Value),
+				})
+			}
+		}
+		output.StaticRoutingEnrichment = staticEnrichments
+	}
+
+	return []EventGridNamespaceTopicSpacesModel{output}, nil
+}
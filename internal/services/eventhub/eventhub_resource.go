@@ -4,6 +4,7 @@
 package eventhub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,8 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
@@ -137,6 +138,25 @@ func resourceEventHub() *pluginsdk.Resource {
 										Required:     true,
 										ValidateFunc: commonids.ValidateStorageAccountID,
 									},
+									"identity": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"type": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(eventhubs.PossibleValuesForCaptureIdentityType(), false),
+												},
+												"user_assigned_identity_id": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -162,6 +182,23 @@ func resourceEventHub() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				identityType := d.Get("capture_description.0.destination.0.identity.0.type").(string)
+				userAssignedIdentityId := d.Get("capture_description.0.destination.0.identity.0.user_assigned_identity_id").(string)
+
+				if identityType == string(eventhubs.CaptureIdentityTypeUserAssigned) && userAssignedIdentityId == "" && d.NewValueKnown("capture_description.0.destination.0.identity.0.user_assigned_identity_id") {
+					return fmt.Errorf("`user_assigned_identity_id` must be specified when the capture destination `identity` type is `%s`", eventhubs.CaptureIdentityTypeUserAssigned)
+				}
+
+				if identityType == string(eventhubs.CaptureIdentityTypeSystemAssigned) && userAssignedIdentityId != "" {
+					return fmt.Errorf("`user_assigned_identity_id` cannot be specified when the capture destination `identity` type is `%s`", eventhubs.CaptureIdentityTypeSystemAssigned)
+				}
+
+				return nil
+			}),
+		),
 	}
 }
 
@@ -366,6 +403,7 @@ func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) *eventhubs.Capt
 					BlobContainer:            utils.String(blobContainerName),
 					StorageAccountResourceId: utils.String(storageAccountId),
 				},
+				Identity: expandEventHubCaptureIdentity(destination["identity"].([]interface{})),
 			}
 		}
 	}
@@ -420,6 +458,8 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 				}
 			}
 
+			destinationOutput["identity"] = flattenEventHubCaptureIdentity(destination.Identity)
+
 			output["destination"] = []interface{}{destinationOutput}
 		}
 
@@ -428,3 +468,44 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 
 	return results
 }
+
+func expandEventHubCaptureIdentity(input []interface{}) *eventhubs.CaptureIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	identityType := eventhubs.CaptureIdentityType(raw["type"].(string))
+	identity := eventhubs.CaptureIdentity{
+		Type: &identityType,
+	}
+
+	if v := raw["user_assigned_identity_id"].(string); v != "" {
+		identity.UserAssignedIdentity = utils.String(v)
+	}
+
+	return &identity
+}
+
+func flattenEventHubCaptureIdentity(input *eventhubs.CaptureIdentity) []interface{} {
+	if input == nil || input.Type == nil {
+		return []interface{}{}
+	}
+
+	userAssignedIdentityId := ""
+	if input.UserAssignedIdentity != nil {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.UserAssignedIdentity)
+		if err == nil {
+			userAssignedIdentityId = id.ID()
+		} else {
+			userAssignedIdentityId = *input.UserAssignedIdentity
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                      string(*input.Type),
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
+}
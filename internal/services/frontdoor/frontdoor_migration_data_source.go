@@ -0,0 +1,651 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frontdoor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// dataSourceFrontDoorMigration reads a (classic) Front Door and exposes its configuration using the shape and values
+// of the `azurerm_cdn_frontdoor_*` resources, so that the (standard/premium) equivalent can be generated from it.
+func dataSourceFrontDoorMigration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceFrontDoorMigrationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"response_timeout_seconds": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"custom_domain": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"host_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"origin_group": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"health_probe": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"interval_in_seconds": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"path": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"request_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"load_balancing": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"additional_latency_in_milliseconds": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"sample_size": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"successful_samples_required": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"origin": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"host_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"origin_host_header": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"http_port": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"https_port": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"weight": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"certificate_name_check_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"route": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"origin_group_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"origin_path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"forwarding_protocol": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"patterns_to_match": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"supported_protocols": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"custom_domain_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"link_to_default_domain": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"cache": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"query_string_caching_behavior": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"query_strings": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"compression_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"redirect_rule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"patterns_to_match": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"supported_protocols": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"custom_domain_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"link_to_default_domain": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"redirect_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"redirect_protocol": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"destination_hostname": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"destination_path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"destination_fragment": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"query_string": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFrontDoorMigrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := frontdoors.NewFrontDoorID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.FrontDoorName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			certificateNameCheckEnabled := true
+			responseTimeoutSeconds := int64(60)
+			if settings := props.BackendPoolsSettings; settings != nil {
+				if settings.EnforceCertificateNameCheck != nil {
+					certificateNameCheckEnabled = *settings.EnforceCertificateNameCheck == frontdoors.EnforceCertificateNameCheckEnabledStateEnabled
+				}
+				if settings.SendRecvTimeoutSeconds != nil {
+					responseTimeoutSeconds = *settings.SendRecvTimeoutSeconds
+				}
+			}
+			d.Set("response_timeout_seconds", responseTimeoutSeconds)
+
+			// the Frontend Endpoint details are only returned when retrieving them individually
+			frontendEndpoints, err := retrieveFrontEndEndpointInformation(ctx, client, id, props.FrontendEndpoints)
+			if err != nil {
+				return fmt.Errorf("retrieving Frontend Endpoints for %s: %+v", id, err)
+			}
+
+			customDomains, defaultEndpointNames := flattenFrontDoorMigrationCustomDomains(frontendEndpoints)
+			if err := d.Set("custom_domain", customDomains); err != nil {
+				return fmt.Errorf("setting `custom_domain`: %+v", err)
+			}
+
+			originGroups, err := flattenFrontDoorMigrationOriginGroups(props, certificateNameCheckEnabled)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("origin_group", originGroups); err != nil {
+				return fmt.Errorf("setting `origin_group`: %+v", err)
+			}
+
+			routes, redirectRules, err := flattenFrontDoorMigrationRoutingRules(props.RoutingRules, defaultEndpointNames)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("route", routes); err != nil {
+				return fmt.Errorf("setting `route`: %+v", err)
+			}
+			if err := d.Set("redirect_rule", redirectRules); err != nil {
+				return fmt.Errorf("setting `redirect_rule`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// flattenFrontDoorMigrationCustomDomains returns the Frontend Endpoints which use a custom host name, along with the
+// names of the Frontend Endpoints which use the default `azurefd.net` host name and so map to the Front Door Endpoint.
+func flattenFrontDoorMigrationCustomDomains(input *[]frontdoors.FrontendEndpoint) ([]interface{}, map[string]struct{}) {
+	customDomains := make([]interface{}, 0)
+	defaultEndpointNames := make(map[string]struct{})
+	if input == nil {
+		return customDomains, defaultEndpointNames
+	}
+
+	for _, endpoint := range *input {
+		name := pointer.From(endpoint.Name)
+		hostName := ""
+		if props := endpoint.Properties; props != nil {
+			hostName = pointer.From(props.HostName)
+		}
+
+		if strings.HasSuffix(strings.ToLower(hostName), ".azurefd.net") {
+			defaultEndpointNames[strings.ToLower(name)] = struct{}{}
+			continue
+		}
+
+		customDomains = append(customDomains, map[string]interface{}{
+			"name":      name,
+			"host_name": hostName,
+		})
+	}
+
+	return customDomains, defaultEndpointNames
+}
+
+func flattenFrontDoorMigrationOriginGroups(input *frontdoors.FrontDoorProperties, certificateNameCheckEnabled bool) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input.BackendPools == nil {
+		return results, nil
+	}
+
+	healthProbes := make(map[string]frontdoors.HealthProbeSettingsProperties)
+	for _, v := range pointer.From(input.HealthProbeSettings) {
+		if v.Name != nil && v.Properties != nil {
+			healthProbes[strings.ToLower(*v.Name)] = *v.Properties
+		}
+	}
+
+	loadBalancings := make(map[string]frontdoors.LoadBalancingSettingsProperties)
+	for _, v := range pointer.From(input.LoadBalancingSettings) {
+		if v.Name != nil && v.Properties != nil {
+			loadBalancings[strings.ToLower(*v.Name)] = *v.Properties
+		}
+	}
+
+	for _, pool := range *input.BackendPools {
+		name := pointer.From(pool.Name)
+		healthProbe := make([]interface{}, 0)
+		loadBalancing := make([]interface{}, 0)
+		origins := make([]interface{}, 0)
+
+		if props := pool.Properties; props != nil {
+			if props.HealthProbeSettings != nil && props.HealthProbeSettings.Id != nil {
+				probeId, err := parse.HealthProbeIDInsensitively(*props.HealthProbeSettings.Id)
+				if err != nil {
+					return nil, fmt.Errorf("parsing the Health Probe ID for Backend Pool %q: %+v", name, err)
+				}
+
+				// a disabled health probe is represented by omitting the `health_probe` block
+				if probe, ok := healthProbes[strings.ToLower(probeId.HealthProbeSettingName)]; ok && pointer.From(probe.EnabledState) != frontdoors.HealthProbeEnabledDisabled {
+					healthProbe = append(healthProbe, map[string]interface{}{
+						"interval_in_seconds": int(pointer.From(probe.IntervalInSeconds)),
+						"path":                pointer.From(probe.Path),
+						"protocol":            string(pointer.From(probe.Protocol)),
+						"request_type":        string(pointer.From(probe.HealthProbeMethod)),
+					})
+				}
+			}
+
+			if props.LoadBalancingSettings != nil && props.LoadBalancingSettings.Id != nil {
+				loadBalancingId, err := parse.LoadBalancingIDInsensitively(*props.LoadBalancingSettings.Id)
+				if err != nil {
+					return nil, fmt.Errorf("parsing the Load Balancing ID for Backend Pool %q: %+v", name, err)
+				}
+
+				if settings, ok := loadBalancings[strings.ToLower(loadBalancingId.LoadBalancingSettingName)]; ok {
+					loadBalancing = append(loadBalancing, map[string]interface{}{
+						"additional_latency_in_milliseconds": int(pointer.From(settings.AdditionalLatencyMilliseconds)),
+						"sample_size":                        int(pointer.From(settings.SampleSize)),
+						"successful_samples_required":        int(pointer.From(settings.SuccessfulSamplesRequired)),
+					})
+				}
+			}
+
+			// Backends aren't named, so the Origins are named after the Origin Group and their position within it
+			for i, backend := range pointer.From(props.Backends) {
+				origins = append(origins, map[string]interface{}{
+					"name":                           fmt.Sprintf("%s-origin-%d", name, i+1),
+					"host_name":                      pointer.From(backend.Address),
+					"origin_host_header":             pointer.From(backend.BackendHostHeader),
+					"http_port":                      int(pointer.From(backend.HTTPPort)),
+					"https_port":                     int(pointer.From(backend.HTTPSPort)),
+					"priority":                       int(pointer.From(backend.Priority)),
+					"weight":                         int(pointer.From(backend.Weight)),
+					"enabled":                        pointer.From(backend.EnabledState) == frontdoors.BackendEnabledStateEnabled,
+					"certificate_name_check_enabled": certificateNameCheckEnabled,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"health_probe":   healthProbe,
+			"load_balancing": loadBalancing,
+			"origin":         origins,
+		})
+	}
+
+	return results, nil
+}
+
+func flattenFrontDoorMigrationRoutingRules(input *[]frontdoors.RoutingRule, defaultEndpointNames map[string]struct{}) ([]interface{}, []interface{}, error) {
+	routes := make([]interface{}, 0)
+	redirectRules := make([]interface{}, 0)
+	if input == nil {
+		return routes, redirectRules, nil
+	}
+
+	for _, rule := range *input {
+		props := rule.Properties
+		if props == nil {
+			continue
+		}
+		name := pointer.From(rule.Name)
+
+		frontendEndpoints, err := flattenFrontDoorFrontendEndpointsSubResources(props.FrontendEndpoints)
+		if err != nil {
+			return nil, nil, fmt.Errorf("flattening the Frontend Endpoints for Routing Rule %q: %+v", name, err)
+		}
+
+		customDomainNames := make([]string, 0)
+		linkToDefaultDomain := false
+		for _, endpoint := range *frontendEndpoints {
+			if _, ok := defaultEndpointNames[strings.ToLower(endpoint)]; ok {
+				linkToDefaultDomain = true
+				continue
+			}
+			customDomainNames = append(customDomainNames, endpoint)
+		}
+
+		enabled := pointer.From(props.EnabledState) == frontdoors.RoutingRuleEnabledStateEnabled
+		patternsToMatch := pointer.From(props.PatternsToMatch)
+		supportedProtocols := flattenFrontDoorAcceptedProtocol(props.AcceptedProtocols)
+
+		switch config := props.RouteConfiguration.(type) {
+		case frontdoors.ForwardingConfiguration:
+			originGroupName := ""
+			if config.BackendPool != nil && config.BackendPool.Id != nil {
+				backendPoolId, err := parse.BackendPoolIDInsensitively(*config.BackendPool.Id)
+				if err != nil {
+					return nil, nil, fmt.Errorf("parsing the Backend Pool ID for Routing Rule %q: %+v", name, err)
+				}
+				originGroupName = backendPoolId.Name
+			}
+
+			// the Route `cache` block is omitted when caching is disabled
+			cache := make([]interface{}, 0)
+			if cacheConfig := config.CacheConfiguration; cacheConfig != nil {
+				queryStrings := make([]string, 0)
+				if v := pointer.From(cacheConfig.QueryParameters); v != "" {
+					queryStrings = strings.Split(v, ",")
+				}
+
+				cache = append(cache, map[string]interface{}{
+					"query_string_caching_behavior": frontDoorMigrationQueryStringCachingBehavior(cacheConfig.QueryParameterStripDirective),
+					"query_strings":                 queryStrings,
+					"compression_enabled":           pointer.From(cacheConfig.DynamicCompression) == frontdoors.DynamicCompressionEnabledEnabled,
+				})
+			}
+
+			routes = append(routes, map[string]interface{}{
+				"name":                   name,
+				"enabled":                enabled,
+				"origin_group_name":      originGroupName,
+				"origin_path":            pointer.From(config.CustomForwardingPath),
+				"forwarding_protocol":    string(pointer.From(config.ForwardingProtocol)),
+				"patterns_to_match":      patternsToMatch,
+				"supported_protocols":    supportedProtocols,
+				"custom_domain_names":    customDomainNames,
+				"link_to_default_domain": linkToDefaultDomain,
+				"cache":                  cache,
+			})
+
+		case frontdoors.RedirectConfiguration:
+			redirectRules = append(redirectRules, map[string]interface{}{
+				"name":                   name,
+				"enabled":                enabled,
+				"patterns_to_match":      patternsToMatch,
+				"supported_protocols":    supportedProtocols,
+				"custom_domain_names":    customDomainNames,
+				"link_to_default_domain": linkToDefaultDomain,
+				"redirect_type":          string(pointer.From(config.RedirectType)),
+				"redirect_protocol":      frontDoorMigrationRedirectProtocol(config.RedirectProtocol),
+				"destination_hostname":   pointer.From(config.CustomHost),
+				"destination_path":       pointer.From(config.CustomPath),
+				"destination_fragment":   pointer.From(config.CustomFragment),
+				"query_string":           pointer.From(config.CustomQueryString),
+			})
+		}
+	}
+
+	return routes, redirectRules, nil
+}
+
+// frontDoorMigrationQueryStringCachingBehavior maps the (classic) Front Door query string strip directive to the
+// equivalent `query_string_caching_behavior` of a Front Door Route
+func frontDoorMigrationQueryStringCachingBehavior(input *frontdoors.FrontDoorQuery) string {
+	switch pointer.From(input) {
+	case frontdoors.FrontDoorQueryStripNone:
+		return "UseQueryString"
+	case frontdoors.FrontDoorQueryStripOnly:
+		return "IgnoreSpecifiedQueryStrings"
+	case frontdoors.FrontDoorQueryStripAllExcept:
+		return "IncludeSpecifiedQueryStrings"
+	default:
+		return "IgnoreQueryString"
+	}
+}
+
+// frontDoorMigrationRedirectProtocol maps the (classic) Front Door redirect protocol to the equivalent
+// `redirect_protocol` of a Front Door Rule's URL Redirect Action
+func frontDoorMigrationRedirectProtocol(input *frontdoors.FrontDoorRedirectProtocol) string {
+	switch pointer.From(input) {
+	case frontdoors.FrontDoorRedirectProtocolHTTPOnly:
+		return "Http"
+	case frontdoors.FrontDoorRedirectProtocolHTTPSOnly:
+		return "Https"
+	default:
+		return "MatchRequest"
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frontdoor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type FrontDoorMigrationDataSource struct{}
+
+func TestAccFrontDoorMigrationDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_frontdoor_migration", "test")
+	d := FrontDoorMigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("custom_domain.#").HasValue("0"),
+				check.That(data.ResourceName).Key("origin_group.#").HasValue("1"),
+				check.That(data.ResourceName).Key("origin_group.0.name").HasValue("backend-bing"),
+				check.That(data.ResourceName).Key("origin_group.0.health_probe.#").HasValue("1"),
+				check.That(data.ResourceName).Key("origin_group.0.origin.0.host_name").HasValue("www.bing.com"),
+				check.That(data.ResourceName).Key("origin_group.0.origin.0.certificate_name_check_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("route.#").HasValue("1"),
+				check.That(data.ResourceName).Key("route.0.origin_group_name").HasValue("backend-bing"),
+				check.That(data.ResourceName).Key("route.0.link_to_default_domain").HasValue("true"),
+				check.That(data.ResourceName).Key("redirect_rule.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccFrontDoorMigrationDataSource_redirect(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_frontdoor_migration", "test")
+	d := FrontDoorMigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.redirect(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route.#").HasValue("0"),
+				check.That(data.ResourceName).Key("redirect_rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("redirect_rule.0.redirect_type").HasValue("Moved"),
+				check.That(data.ResourceName).Key("redirect_rule.0.redirect_protocol").HasValue("Http"),
+				check.That(data.ResourceName).Key("redirect_rule.0.destination_hostname").HasValue("127.0.0.1"),
+			),
+		},
+	})
+}
+
+func (FrontDoorMigrationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_frontdoor_migration" "test" {
+  name                = azurerm_frontdoor.test.name
+  resource_group_name = azurerm_frontdoor.test.resource_group_name
+}
+`, FrontDoorResource{}.basic(data))
+}
+
+func (FrontDoorMigrationDataSource) redirect(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_frontdoor_migration" "test" {
+  name                = azurerm_frontdoor.test.name
+  resource_group_name = azurerm_frontdoor.test.resource_group_name
+}
+`, FrontDoorResource{}.routingRule(data))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -113,6 +114,52 @@ func deleteAndOptionallyPurge(ctx context.Context, description string, shouldPur
 	return nil
 }
 
+type dataPlaneAccessCheckFunc func(ctx context.Context) (autorest.Response, error)
+
+// waitForDataPlaneAccess polls the Key Vault Data Plane until the caller is authorized to perform an operation,
+// since newly created Role Assignments can take several minutes to become effective - during which time requests
+// return a 403. Any other response (including other errors) ends the wait, and is left to the caller to handle.
+// Access Policies take effect immediately, so a 403 from a Key Vault not using RBAC Authorization is a genuine
+// permissions error - as such this only waits when the Key Vault has RBAC Authorization enabled.
+func waitForDataPlaneAccess(ctx context.Context, keyVaultsClient *client.Client, keyVaultId commonids.KeyVaultId, description string, check dataPlaneAccessCheckFunc) error {
+	timeout, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context is missing a timeout")
+	}
+
+	keyVault, err := keyVaultsClient.VaultsClient.Get(ctx, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", keyVaultId, err)
+	}
+	if keyVault.Model == nil || !utils.NormaliseNilableBool(keyVault.Model.Properties.EnableRbacAuthorization) {
+		log.Printf("[DEBUG] %s doesn't use RBAC Authorization - skipping waiting for access to %s", keyVaultId, description)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for access to %s to be authorized..", description)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Forbidden"},
+		Target:  []string{"Authorized"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := check(ctx)
+			if err != nil && utils.ResponseWasForbidden(resp) {
+				return resp, "Forbidden", nil
+			}
+
+			return resp, "Authorized", nil
+		},
+		// Role Assignments are replicated, so a single successful response doesn't mean all replicas are authorizing the request
+		ContinuousTargetOccurence: 2,
+		PollInterval:              10 * time.Second,
+		Timeout:                   time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for access to %s to be authorized: %+v", description, err)
+	}
+
+	return nil
+}
+
 func keyVaultChildItemRefreshFunc(secretUri string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Checking to see if KeyVault Secret %q is available..", secretUri)
@@ -90,6 +90,12 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 				},
 			},
 
+			"renew": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"certificate"},
+			},
+
 			"certificate_policy": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -485,6 +491,16 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("looking up Base URI for Certificate %q in %s: %+v", name, *keyVaultId, err)
 	}
 
+	if meta.(*clients.Client).Features.KeyVault.WaitForRBACPropagation {
+		err := waitForDataPlaneAccess(ctx, keyVaultsClient, *keyVaultId, fmt.Sprintf("Certificates in %s", *keyVaultId), func(ctx context.Context) (autorest.Response, error) {
+			page, err := client.GetCertificates(ctx, *keyVaultBaseUrl, utils.Int32(1), utils.Bool(false))
+			return page.Response().Response, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	existing, err := client.GetCertificate(ctx, *keyVaultBaseUrl, name, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(existing.Response) {
@@ -622,27 +638,9 @@ func resourceKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	// update lifetime_action only should not recreate a certificate
-	var lifeTimeOld, lifeTimeNew interface{}
-	var policyOld, policyNew map[string]interface{}
-
-	policyOldRaw, policyNewRaw := d.GetChange("certificate_policy")
-	policyOldList, policyNewList := policyOldRaw.([]interface{}), policyNewRaw.([]interface{})
-
-	if len(policyOldList) > 0 {
-		policyOld = policyOldList[0].(map[string]interface{})
-		lifeTimeOld = policyOld["lifetime_action"]
-		delete(policyOld, "lifetime_action")
-	}
-	if len(policyNewList) > 0 {
-		policyNew = policyNewList[0].(map[string]interface{})
-		lifeTimeNew = policyNew["lifetime_action"]
-		delete(policyNew, "lifetime_action")
-	}
-
-	// do not recreate cerfiticate when only lifetime_action changes
-	if !cmp.Equal(policyNewList, policyOldList) {
-		policyNew["lifetime_action"] = lifeTimeNew
+	policyOld, policyNew := d.GetChange("certificate_policy")
+	if d.HasChange("renew") || keyVaultCertificatePolicyRequiresNewVersion(policyOld, policyNew) {
+		// generating a new version of the certificate also updates the policy
 		newCert, err := createCertificate(d, meta)
 		if err != nil {
 			return err
@@ -652,20 +650,22 @@ func resourceKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{})
 			return err
 		}
 		d.SetId(certificateId.ID())
-	}
+	} else if d.HasChange("certificate_policy") {
+		// changes to the issuer or lifetime actions only apply to subsequent versions, so only the policy needs updating
+		policy, err := expandKeyVaultCertificatePolicy(d)
+		if err != nil {
+			return fmt.Errorf("expanding certificate policy: %s", err)
+		}
 
-	if updateLifetime := !cmp.Equal(lifeTimeOld, lifeTimeNew); d.HasChange("tags") || updateLifetime {
-		patch := keyvault.CertificateUpdateParameters{}
-		if d.HasChange("tags") {
-			if t, ok := d.GetOk("tags"); ok {
-				patch.Tags = tags.Expand(t.(map[string]interface{}))
-			}
+		if _, err = client.UpdateCertificatePolicy(ctx, id.KeyVaultBaseUrl, id.Name, *policy); err != nil {
+			return fmt.Errorf("updating policy for Certificate %q in Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
 		}
+	}
 
-		if updateLifetime {
-			patch.CertificatePolicy = &keyvault.CertificatePolicy{
-				LifetimeActions: expandKeyVaultCertificatePolicyLifetimeAction(lifeTimeNew),
-			}
+	if d.HasChange("tags") {
+		patch := keyvault.CertificateUpdateParameters{}
+		if t, ok := d.GetOk("tags"); ok {
+			patch.Tags = tags.Expand(t.(map[string]interface{}))
 		}
 
 		if _, err = client.UpdateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "", patch); err != nil {
@@ -675,6 +675,29 @@ func resourceKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{})
 	return resourceKeyVaultCertificateRead(d, meta)
 }
 
+// keyVaultCertificatePolicyRequiresNewVersion determines whether a change to the `certificate_policy` requires a new
+// version of the certificate to be generated - which isn't the case when only `issuer_parameters` or `lifetime_action` change.
+func keyVaultCertificatePolicyRequiresNewVersion(oldRaw, newRaw interface{}) bool {
+	withoutPolicyOnlyFields := func(input interface{}) []interface{} {
+		output := make([]interface{}, 0)
+		for _, item := range input.([]interface{}) {
+			policy := make(map[string]interface{})
+			if item != nil {
+				for k, v := range item.(map[string]interface{}) {
+					if k == "issuer_parameters" || k == "lifetime_action" {
+						continue
+					}
+					policy[k] = v
+				}
+			}
+			output = append(output, policy)
+		}
+		return output
+	}
+
+	return !cmp.Equal(withoutPolicyOnlyFields(oldRaw), withoutPolicyOnlyFields(newRaw))
+}
+
 func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		operation, err := client.GetCertificateOperation(ctx, keyVaultBaseUrl, name)
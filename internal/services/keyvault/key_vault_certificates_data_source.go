@@ -4,7 +4,10 @@
 package keyvault
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -44,6 +47,14 @@ func dataSourceKeyVaultCertificates() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"tags_filter": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"certificates": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -64,6 +75,21 @@ func dataSourceKeyVaultCertificates() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"expires": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"not_before": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"thumbprint": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"tags": tags.SchemaDataSource(),
 					},
 				},
@@ -97,22 +123,29 @@ func dataSourceKeyVaultCertificatesRead(d *pluginsdk.ResourceData, meta interfac
 
 	d.SetId(keyVaultId.ID())
 
-	var names []string
-	var certs []map[string]interface{}
-	if certificateList.Response().Value != nil {
-		for certificateList.NotDone() {
-			for _, v := range *certificateList.Response().Value {
-				nestedItem, err := parse.ParseOptionallyVersionedNestedItemID(*v.ID)
-				if err != nil {
-					return err
-				}
-				names = append(names, nestedItem.Name)
-				certs = append(certs, expandCertificate(nestedItem.Name, v))
-				err = certificateList.NextWithContext(ctx)
-				if err != nil {
-					return fmt.Errorf("retrieving next page of Certificates from %s: %+v", *keyVaultId, err)
-				}
+	filterTags := d.Get("tags_filter").(map[string]interface{})
+
+	names := make([]string, 0)
+	certs := make([]map[string]interface{}, 0)
+	for certificateList.NotDone() {
+		v := certificateList.Value()
+		if v.ID != nil && certificateMatchesTags(v.Tags, filterTags) {
+			nestedItem, err := parse.ParseOptionallyVersionedNestedItemID(*v.ID)
+			if err != nil {
+				return err
 			}
+
+			cert, err := expandCertificate(nestedItem.Name, v)
+			if err != nil {
+				return err
+			}
+
+			names = append(names, nestedItem.Name)
+			certs = append(certs, cert)
+		}
+
+		if err := certificateList.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("retrieving next page of Certificates from %s: %+v", *keyVaultId, err)
 		}
 	}
 
@@ -123,19 +156,49 @@ func dataSourceKeyVaultCertificatesRead(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
-func expandCertificate(name string, item keyvault.CertificateItem) map[string]interface{} {
+func expandCertificate(name string, item keyvault.CertificateItem) (map[string]interface{}, error) {
 	var cert = map[string]interface{}{
 		"name": name,
 		"id":   *item.ID,
 	}
 
-	if item.Attributes != nil && item.Attributes.Enabled != nil {
-		cert["enabled"] = *item.Attributes.Enabled
+	if item.Attributes != nil {
+		if item.Attributes.Enabled != nil {
+			cert["enabled"] = *item.Attributes.Enabled
+		}
+
+		if item.Attributes.Expires != nil {
+			cert["expires"] = time.Time(*item.Attributes.Expires).UTC().Format(time.RFC3339)
+		}
+
+		if item.Attributes.NotBefore != nil {
+			cert["not_before"] = time.Time(*item.Attributes.NotBefore).UTC().Format(time.RFC3339)
+		}
+	}
+
+	if item.X509Thumbprint != nil {
+		x509Thumbprint, err := base64.RawURLEncoding.DecodeString(*item.X509Thumbprint)
+		if err != nil {
+			return nil, fmt.Errorf("decoding the thumbprint of Certificate %q: %+v", name, err)
+		}
+		cert["thumbprint"] = strings.ToUpper(hex.EncodeToString(x509Thumbprint))
 	}
 
 	if item.Tags != nil {
 		cert["tags"] = tags.Flatten(item.Tags)
 	}
 
-	return cert
+	return cert, nil
+}
+
+// certificateMatchesTags returns whether the certificate has all of the tags specified in the filter
+func certificateMatchesTags(input map[string]*string, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		value, ok := input[k]
+		if !ok || value == nil || *value != v.(string) {
+			return false
+		}
+	}
+
+	return true
 }
@@ -28,6 +28,26 @@ func TestAccDataSourceKeyVaultCertificates_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceKeyVaultCertificates_tagsFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_certificates", "test")
+	r := KeyVaultCertificatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.tagsFilter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("certificates.#").HasValue("1"),
+				check.That(data.ResourceName).Key("certificates.0.name").HasValue("certificate-tagged"),
+				check.That(data.ResourceName).Key("certificates.0.expires").Exists(),
+				check.That(data.ResourceName).Key("certificates.0.not_before").Exists(),
+				check.That(data.ResourceName).Key("certificates.0.thumbprint").Exists(),
+				check.That(data.ResourceName).Key("certificates.0.tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
 func (KeyVaultCertificatesDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -85,3 +105,55 @@ data "azurerm_key_vault_certificates" "test" {
 }
 `, KeyVaultCertificateResource{}.basicGenerate(data))
 }
+
+func (KeyVaultCertificatesDataSource) tagsFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate" "tagged" {
+  name         = "certificate-tagged"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage = [
+        "digitalSignature",
+        "keyEncipherment",
+      ]
+
+      subject            = "CN=hello-world"
+      validity_in_months = 12
+    }
+  }
+
+  tags = {
+    rotation = "enabled"
+  }
+}
+
+data "azurerm_key_vault_certificates" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+
+  tags_filter = {
+    rotation = "enabled"
+  }
+
+  depends_on = [azurerm_key_vault_certificate.test, azurerm_key_vault_certificate.tagged]
+}
+`, KeyVaultCertificateResource{}.basicGenerate(data))
+}
@@ -303,6 +303,16 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("looking up Key %q vault url from id %q: %+v", name, *keyVaultId, err)
 	}
 
+	if meta.(*clients.Client).Features.KeyVault.WaitForRBACPropagation {
+		err := waitForDataPlaneAccess(ctx, keyVaultsClient, *keyVaultId, fmt.Sprintf("Keys in %s", *keyVaultId), func(ctx context.Context) (autorest.Response, error) {
+			page, err := client.GetKeys(ctx, *keyVaultBaseUri, utils.Int32(1))
+			return page.Response().Response, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	existing, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(existing.Response) {
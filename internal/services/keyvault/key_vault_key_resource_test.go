@@ -397,6 +397,21 @@ func TestAccKeyVaultKey_RotationPolicyUnauthorized(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_rbacPropagation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rbacPropagation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+	})
+}
+
 func (r KeyVaultKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	client := clients.KeyVault
 	subscriptionId := clients.Account.SubscriptionId
@@ -1117,3 +1132,28 @@ resource "azurerm_key_vault_key" "test" {
 }
 `, r.template(data, "standard"), data.RandomString)
 }
+
+func (KeyVaultKeyResource) rbacPropagation(data acceptance.TestData) string {
+	template := KeyVaultResource{}.rbacPropagationTemplate(data, "Key Vault Crypto Officer")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, template, data.RandomString)
+}
@@ -632,6 +632,42 @@ resource "azurerm_subnet" "test_b" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (KeyVaultResource) rbacPropagationTemplate(data acceptance.TestData, roleDefinitionName string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      wait_for_rbac_propagation = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv-%s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+  enable_rbac_authorization  = true
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_key_vault.test.id
+  role_definition_name = "%s"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, roleDefinitionName)
+}
+
 func (KeyVaultResource) networkAcls(data acceptance.TestData) string {
 	template := KeyVaultResource{}.networkAclsTemplate(data)
 	return fmt.Sprintf(`
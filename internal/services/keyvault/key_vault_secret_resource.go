@@ -122,6 +122,16 @@ func resourceKeyVaultSecretCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("looking up Secret %q vault url from id %q: %+v", name, *keyVaultId, err)
 	}
 
+	if meta.(*clients.Client).Features.KeyVault.WaitForRBACPropagation {
+		err := waitForDataPlaneAccess(ctx, keyVaultsClient, *keyVaultId, fmt.Sprintf("Secrets in %s", *keyVaultId), func(ctx context.Context) (autorest.Response, error) {
+			page, err := client.GetSecrets(ctx, *keyVaultBaseUrl, utils.Int32(1))
+			return page.Response().Response, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	existing, err := client.GetSecret(ctx, *keyVaultBaseUrl, name, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(existing.Response) {
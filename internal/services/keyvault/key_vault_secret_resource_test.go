@@ -182,6 +182,21 @@ func TestAccKeyVaultSecret_recovery(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultSecret_rbacPropagation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "test")
+	r := KeyVaultSecretResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rbacPropagation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKeyVaultSecret_withExternalAccessPolicy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "test")
 	r := KeyVaultSecretResource{}
@@ -420,6 +435,21 @@ resource "azurerm_key_vault_secret" "test" {
 `, purge, r.template(data), data.RandomString, value)
 }
 
+func (KeyVaultSecretResource) rbacPropagation(data acceptance.TestData) string {
+	template := KeyVaultResource{}.rbacPropagationTemplate(data, "Key Vault Secrets Officer")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%s"
+  value        = "rick-and-morty"
+  key_vault_id = azurerm_key_vault.test.id
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, template, data.RandomString)
+}
+
 func (KeyVaultSecretResource) withExternalAccessPolicy(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -58,6 +58,11 @@ func resourceLogAnalyticsClusterCustomerManagedKey() *pluginsdk.Resource {
 				Required:     true,
 				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 			},
+
+			"key_vault_key_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -197,6 +202,7 @@ func resourceLogAnalyticsClusterCustomerManagedKeyRead(d *pluginsdk.ResourceData
 	}
 
 	keyVaultKeyId := ""
+	keyVaultKeyVersion := ""
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			if kvProps := props.KeyVaultProperties; kvProps != nil {
@@ -219,6 +225,16 @@ func resourceLogAnalyticsClusterCustomerManagedKeyRead(d *pluginsdk.ResourceData
 					return err
 				}
 				keyVaultKeyId = keyId.ID()
+				keyVaultKeyVersion = keyVersion
+
+				// when a versionless Key ID has been configured the key is auto-rotated, so we need to persist
+				// the versionless ID to the state file else Terraform will try to revert to the version which
+				// was resolved by Azure at the time the key was assigned
+				if v, ok := d.GetOk("key_vault_key_id"); ok {
+					if configuredKeyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v.(string)); err == nil && configuredKeyId.Version == "" {
+						keyVaultKeyId = keyId.VersionlessID()
+					}
+				}
 			}
 		}
 	}
@@ -230,6 +246,7 @@ func resourceLogAnalyticsClusterCustomerManagedKeyRead(d *pluginsdk.ResourceData
 
 	d.Set("log_analytics_cluster_id", d.Id())
 	d.Set("key_vault_key_id", keyVaultKeyId)
+	d.Set("key_vault_key_version", keyVaultKeyVersion)
 
 	return nil
 }
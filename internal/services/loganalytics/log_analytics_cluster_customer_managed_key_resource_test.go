@@ -66,6 +66,27 @@ func TestAccLogAnalyticsClusterCustomerManagedKey_update(t *testing.T) {
 	})
 }
 
+func TestAccLogAnalyticsClusterCustomerManagedKey_versionless(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_cluster_customer_managed_key", "test")
+	r := LogAnalyticsClusterCustomerManagedKeyResource{}
+
+	if os.Getenv("ARM_RUN_TEST_LOG_ANALYTICS_CLUSTERS") == "" {
+		t.Skip("Skipping as ARM_RUN_TEST_LOG_ANALYTICS_CLUSTERS is not specified")
+		return
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.versionless(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_vault_key_version").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("key_vault_key_id"),
+	})
+}
+
 func (t LogAnalyticsClusterCustomerManagedKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := clusters.ParseClusterID(state.ID)
 	if err != nil {
@@ -229,3 +250,16 @@ resource "azurerm_log_analytics_cluster_customer_managed_key" "test" {
 
 `, r.template(data), data.RandomString)
 }
+
+func (r LogAnalyticsClusterCustomerManagedKeyResource) versionless(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_cluster_customer_managed_key" "test" {
+  log_analytics_cluster_id = azurerm_log_analytics_cluster.test.id
+  key_vault_key_id         = azurerm_key_vault_key.test.versionless_id
+
+  depends_on = [azurerm_key_vault_access_policy.test]
+}
+`, r.template(data))
+}
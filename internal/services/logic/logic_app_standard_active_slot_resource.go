@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceLogicAppStandardActiveSlot swaps a Logic App Standard Slot with Production. There's no actual resource being
+// managed, so changing the `slot_id` swaps the new Slot in place rather than recreating the resource.
+func resourceLogicAppStandardActiveSlot() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogicAppStandardActiveSlotCreateUpdate,
+		Read:   resourceLogicAppStandardActiveSlotRead,
+		Update: resourceLogicAppStandardActiveSlotCreateUpdate,
+		Delete: resourceLogicAppStandardActiveSlotDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogicAppStandardID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"slot_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LogicAppStandardSlotID,
+			},
+
+			// Note: This setting controls the ambiguously named `PreserveVnet`
+			"overwrite_network_config": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"last_successful_swap": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLogicAppStandardActiveSlotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	slotId, err := parse.LogicAppStandardSlotID(d.Get("slot_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogicAppStandardID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	input := web.CsmSlotEntity{
+		TargetSlot:   utils.String(slotId.SlotName),
+		PreserveVnet: utils.Bool(d.Get("overwrite_network_config").(bool)),
+	}
+
+	future, err := client.SwapSlotWithProduction(ctx, id.ResourceGroup, id.SiteName, input)
+	if err != nil {
+		return fmt.Errorf("swapping %s with Production: %+v", *slotId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be swapped with Production: %+v", *slotId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogicAppStandardActiveSlotRead(d, meta)
+}
+
+func resourceLogicAppStandardActiveSlotRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.SiteProperties == nil || resp.SiteProperties.SlotSwapStatus == nil {
+		return fmt.Errorf("retrieving %s: `slotSwapStatus` was nil", *id)
+	}
+
+	swapStatus := resp.SiteProperties.SlotSwapStatus
+	if swapStatus.SourceSlotName != nil {
+		d.Set("slot_id", parse.NewLogicAppStandardSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, *swapStatus.SourceSlotName).ID())
+	}
+
+	lastSwap := ""
+	if swapStatus.TimestampUtc != nil {
+		lastSwap = swapStatus.TimestampUtc.Format(time.RFC3339)
+	}
+	d.Set("last_successful_swap", lastSwap)
+
+	// this can't be read from the service as it's part of the swap request only, so default it for imports
+	overwriteNetworking := true
+	if v, ok := d.GetOk("overwrite_network_config"); ok {
+		overwriteNetworking = v.(bool)
+	}
+	d.Set("overwrite_network_config", overwriteNetworking)
+
+	return nil
+}
+
+func resourceLogicAppStandardActiveSlotDelete(_ *pluginsdk.ResourceData, _ interface{}) error {
+	// deleting doesn't change the active slot nor revert to any previous state, so there's nothing to do here
+	return nil
+}
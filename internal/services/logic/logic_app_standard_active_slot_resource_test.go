@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogicAppStandardActiveSlotResource struct{}
+
+func TestAccLogicAppStandardActiveSlot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_active_slot", "test")
+	r := LogicAppStandardActiveSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("last_successful_swap").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardActiveSlot_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_active_slot", "test")
+	r := LogicAppStandardActiveSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogicAppStandardActiveSlotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LogicAppStandardID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	slotId, err := parse.LogicAppStandardSlotID(state.Attributes["slot_id"])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Web.AppServicesClient.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.SiteProperties == nil || resp.SiteProperties.SlotSwapStatus == nil || resp.SiteProperties.SlotSwapStatus.SourceSlotName == nil {
+		return nil, fmt.Errorf("missing slot swap status for %s", *id)
+	}
+
+	return utils.Bool(strings.EqualFold(*resp.SiteProperties.SlotSwapStatus.SourceSlotName, slotId.SlotName)), nil
+}
+
+func (r LogicAppStandardActiveSlotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_active_slot" "test" {
+  slot_id = azurerm_logic_app_standard_slot.test.id
+}
+`, r.template(data))
+}
+
+func (r LogicAppStandardActiveSlotResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_active_slot" "test" {
+  slot_id = azurerm_logic_app_standard_slot.test2.id
+}
+`, r.template(data))
+}
+
+func (r LogicAppStandardActiveSlotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_slot" "test" {
+  name                       = "acctest-slot-1"
+  logic_app_id               = azurerm_logic_app_standard.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+}
+
+resource "azurerm_logic_app_standard_slot" "test2" {
+  name                       = "acctest-slot-2"
+  logic_app_id               = azurerm_logic_app_standard.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+}
+`, LogicAppStandardResource{}.basic(data))
+}
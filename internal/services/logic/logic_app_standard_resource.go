@@ -4,6 +4,7 @@
 package logic
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -41,6 +42,8 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(logicAppStandardContentOverVnetCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -117,43 +120,7 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 
 			"site_config": schemaLogicAppStandardSiteConfig(),
 
-			"connection_string": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				Computed: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(web.ConnectionStringTypeAPIHub),
-								string(web.ConnectionStringTypeCustom),
-								string(web.ConnectionStringTypeDocDb),
-								string(web.ConnectionStringTypeEventHub),
-								string(web.ConnectionStringTypeMySQL),
-								string(web.ConnectionStringTypeNotificationHub),
-								string(web.ConnectionStringTypePostgreSQL),
-								string(web.ConnectionStringTypeRedisCache),
-								string(web.ConnectionStringTypeServiceBus),
-								string(web.ConnectionStringTypeSQLAzure),
-								string(web.ConnectionStringTypeSQLServer),
-							}, false),
-						},
-
-						"value": {
-							Type:      pluginsdk.TypeString,
-							Required:  true,
-							Sensitive: true,
-						},
-					},
-				},
-			},
+			"connection_string": schemaLogicAppStandardConnectionString(),
 
 			"storage_account_name": {
 				Type:         pluginsdk.TypeString,
@@ -214,23 +181,7 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 				Computed: true,
 			},
 
-			"site_credential": {
-				Type:     pluginsdk.TypeList,
-				Computed: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"username": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-						"password": {
-							Type:      pluginsdk.TypeString,
-							Computed:  true,
-							Sensitive: true,
-						},
-					},
-				},
-			},
+			"site_credential": schemaLogicAppStandardSiteCredential(),
 
 			"virtual_network_subnet_id": {
 				Type:         pluginsdk.TypeString,
@@ -298,7 +249,7 @@ func resourceLogicAppStandardCreate(d *pluginsdk.ResourceData, meta interface{})
 	VirtualNetworkSubnetID := d.Get("virtual_network_subnet_id").(string)
 	t := d.Get("tags").(map[string]interface{})
 
-	basicAppSettings, err := getBasicLogicAppSettings(d, *storageAccountDomainSuffix)
+	basicAppSettings, err := getBasicLogicAppSettings(d, *storageAccountDomainSuffix, id.SiteName)
 	if err != nil {
 		return err
 	}
@@ -388,7 +339,7 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 	httpsOnly := d.Get("https_only").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
-	basicAppSettings, err := getBasicLogicAppSettings(d, *storageAccountDomainSuffix)
+	basicAppSettings, err := getBasicLogicAppSettings(d, *storageAccountDomainSuffix, id.SiteName)
 	if err != nil {
 		return err
 	}
@@ -406,7 +357,7 @@ func resourceLogicAppStandardUpdate(d *pluginsdk.ResourceData, meta interface{})
 	siteConfig.AppSettings = &basicAppSettings
 
 	// WEBSITE_VNET_ROUTE_ALL is superseded by a setting in site_config that defaults to false from 2021-02-01
-	appSettings, err := expandLogicAppStandardSettings(d, *storageAccountDomainSuffix)
+	appSettings, err := expandLogicAppStandardSettings(d, *storageAccountDomainSuffix, id.SiteName)
 	if err != nil {
 		return fmt.Errorf("expanding `app_settings`: %+v", err)
 	}
@@ -575,6 +526,57 @@ func resourceLogicAppStandardRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if err := setLogicAppStandardAppSettings(d, appSettings); err != nil {
+		return err
+	}
+
+	identity, err := flattenLogicAppStandardIdentity(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %s", err)
+	}
+
+	configResp, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return fmt.Errorf("retrieving the configuration for %s: %+v", *id, err)
+	}
+
+	siteConfig := flattenLogicAppStandardSiteConfig(configResp.SiteConfig)
+	if err = d.Set("site_config", siteConfig); err != nil {
+		return err
+	}
+
+	siteCred := flattenLogicAppStandardSiteCredential(siteCredResp.UserProperties)
+	if err = d.Set("site_credential", siteCred); err != nil {
+		return err
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceLogicAppStandardDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.SiteName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// setLogicAppStandardAppSettings sets the arguments which are stored as App Settings, and the remaining `app_settings`
+func setLogicAppStandardAppSettings(d *pluginsdk.ResourceData, appSettings map[string]string) error {
 	connectionString := appSettings["AzureWebJobsStorage"]
 
 	// This teases out the necessary attributes from the storage connection string
@@ -619,50 +621,20 @@ func resourceLogicAppStandardRead(d *pluginsdk.ResourceData, meta interface{}) e
 	delete(appSettings, "FUNCTIONS_EXTENSION_VERSION")
 	delete(appSettings, "WEBSITE_CONTENTSHARE")
 
-	if err = d.Set("app_settings", appSettings); err != nil {
-		return err
-	}
-
-	identity, err := flattenLogicAppStandardIdentity(resp.Identity)
-	if err != nil {
-		return fmt.Errorf("flattening `identity`: %+v", err)
-	}
-	if err := d.Set("identity", identity); err != nil {
-		return fmt.Errorf("setting `identity`: %s", err)
-	}
-
-	configResp, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
-	if err != nil {
-		return fmt.Errorf("retrieving the configuration for %s: %+v", *id, err)
-	}
-
-	siteConfig := flattenLogicAppStandardSiteConfig(configResp.SiteConfig)
-	if err = d.Set("site_config", siteConfig); err != nil {
-		return err
-	}
-
-	siteCred := flattenLogicAppStandardSiteCredential(siteCredResp.UserProperties)
-	if err = d.Set("site_credential", siteCred); err != nil {
-		return err
-	}
-
-	return tags.FlattenAndSet(d, resp.Tags)
+	return d.Set("app_settings", appSettings)
 }
 
-func resourceLogicAppStandardDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
-	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
-	defer cancel()
-
-	id, err := parse.LogicAppStandardID(d.Id())
-	if err != nil {
-		return err
+// logicAppStandardContentOverVnetCustomizeDiff ensures a predefined content share is used when the content is accessed
+// over a Virtual Network, since the share can't be created automatically in that case. Existing apps retain the content
+// share they were created with, so this only applies at creation time.
+// https://learn.microsoft.com/en-us/azure/azure-functions/functions-app-settings#website_contentovervnet
+func logicAppStandardContentOverVnetCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("app_settings") {
+		return nil
 	}
 
-	deleteMetrics := true
-	deleteEmptyServerFarm := false
-	if _, err := client.Delete(ctx, id.ResourceGroup, id.SiteName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
-		return fmt.Errorf("deleting %s: %+v", *id, err)
+	if _, contentOverVnetEnabled := d.Get("app_settings").(map[string]interface{})["WEBSITE_CONTENTOVERVNET"]; contentOverVnetEnabled && d.GetRawConfig().GetAttr("storage_account_share_name").IsNull() {
+		return fmt.Errorf("`storage_account_share_name` must be specified and set to a predefined share when the app_setting `WEBSITE_CONTENTOVERVNET` is specified")
 	}
 
 	return nil
@@ -671,6 +643,7 @@ func resourceLogicAppStandardDelete(d *pluginsdk.ResourceData, meta interface{})
 func getBasicLogicAppSettings(
 	d *pluginsdk.ResourceData,
 	endpointSuffix string,
+	name string,
 ) ([]web.NameValuePair, error) {
 	storagePropName := "AzureWebJobsStorage"
 	functionVersionPropName := "FUNCTIONS_EXTENSION_VERSION"
@@ -689,7 +662,7 @@ func getBasicLogicAppSettings(
 	)
 	functionVersion := d.Get("version").(string)
 
-	contentShare := strings.ToLower(d.Get("name").(string)) + "-content"
+	contentShare := strings.ToLower(name) + "-content"
 	if _, ok := d.GetOk("storage_account_share_name"); ok {
 		contentShare = d.Get("storage_account_share_name").(string)
 	}
@@ -895,6 +868,66 @@ func schemaLogicAppStandardSiteConfig() *pluginsdk.Schema {
 	}
 }
 
+func schemaLogicAppStandardConnectionString() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.ConnectionStringTypeAPIHub),
+						string(web.ConnectionStringTypeCustom),
+						string(web.ConnectionStringTypeDocDb),
+						string(web.ConnectionStringTypeEventHub),
+						string(web.ConnectionStringTypeMySQL),
+						string(web.ConnectionStringTypeNotificationHub),
+						string(web.ConnectionStringTypePostgreSQL),
+						string(web.ConnectionStringTypeRedisCache),
+						string(web.ConnectionStringTypeServiceBus),
+						string(web.ConnectionStringTypeSQLAzure),
+						string(web.ConnectionStringTypeSQLServer),
+					}, false),
+				},
+
+				"value": {
+					Type:      pluginsdk.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaLogicAppStandardSiteCredential() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"username": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"password": {
+					Type:      pluginsdk.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
 func schemaLogicAppCorsSettings() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -1551,10 +1584,11 @@ func flattenLogicAppStandardUserAssignedIdentity(input map[string]*web.UserAssig
 func expandLogicAppStandardSettings(
 	d *pluginsdk.ResourceData,
 	endpointSuffix string,
+	name string,
 ) (map[string]*string, error) {
 	output := make(map[string]*string)
 	appSettings := expandAppSettings(d)
-	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix)
+	basicAppSettings, err := getBasicLogicAppSettings(d, endpointSuffix, name)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,533 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLogicAppStandardSlot() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogicAppStandardSlotCreate,
+		Read:   resourceLogicAppStandardSlotRead,
+		Update: resourceLogicAppStandardSlotUpdate,
+		Delete: resourceLogicAppStandardSlotDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.LogicAppStandardSlotID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(logicAppStandardContentOverVnetCustomizeDiff),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardName,
+			},
+
+			"logic_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicAppStandardID,
+			},
+
+			"app_service_plan_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: commonids.ValidateAppServicePlanID,
+			},
+
+			"app_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"use_extension_bundle": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"bundle_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "[1.*, 2.0.0)",
+			},
+
+			"client_affinity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"client_certificate_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Required",
+					"Optional",
+				}, false),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"https_only": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+			"site_config": schemaLogicAppStandardSiteConfig(),
+
+			"connection_string": schemaLogicAppStandardConnectionString(),
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountName,
+			},
+
+			"storage_account_access_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_account_share_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default: func() interface{} {
+					if !features.FourPointOhBeta() {
+						return "~3"
+					}
+					return "~4"
+				}(),
+			},
+
+			"virtual_network_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateSubnetID,
+			},
+
+			"tags": tags.Schema(),
+
+			// Computed Only
+			"custom_domain_verification_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"default_hostname": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"possible_outbound_ip_addresses": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"site_credential": schemaLogicAppStandardSiteCredential(),
+		},
+	}
+}
+
+func resourceLogicAppStandardSlotCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	logicAppId, err := parse.LogicAppStandardID(d.Get("logic_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLogicAppStandardSlotID(logicAppId.SubscriptionId, logicAppId.ResourceGroup, logicAppId.SiteName, d.Get("name").(string))
+
+	existing, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_logic_app_standard_slot", id.ID())
+	}
+
+	logicApp, err := client.Get(ctx, logicAppId.ResourceGroup, logicAppId.SiteName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *logicAppId, err)
+	}
+	if logicApp.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *logicAppId)
+	}
+
+	// slots are created in the same App Service Plan as the Logic App unless otherwise specified
+	appServicePlanId := d.Get("app_service_plan_id").(string)
+	if appServicePlanId == "" && logicApp.SiteProperties != nil && logicApp.SiteProperties.ServerFarmID != nil {
+		appServicePlanId = *logicApp.SiteProperties.ServerFarmID
+	}
+
+	siteEnvelope, basicAppSettings, err := expandLogicAppStandardSlot(d, meta, id, azure.NormalizeLocation(*logicApp.Location), appServicePlanId)
+	if err != nil {
+		return err
+	}
+
+	// Some appSettings declared by user are required at creation time so we will combine both settings
+	appSettings := expandAppSettings(d)
+	appSettings = append(appSettings, basicAppSettings...)
+	siteEnvelope.SiteProperties.SiteConfig.AppSettings = &appSettings
+
+	if subnetId := d.Get("virtual_network_subnet_id").(string); subnetId != "" {
+		siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(subnetId)
+	}
+
+	future, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, *siteEnvelope, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceLogicAppStandardSlotUpdate(d, meta)
+}
+
+func resourceLogicAppStandardSlotUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardSlotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *id)
+	}
+
+	appServicePlanId := d.Get("app_service_plan_id").(string)
+	if appServicePlanId == "" && existing.SiteProperties != nil && existing.SiteProperties.ServerFarmID != nil {
+		appServicePlanId = *existing.SiteProperties.ServerFarmID
+	}
+
+	siteEnvelope, basicAppSettings, err := expandLogicAppStandardSlot(d, meta, *id, azure.NormalizeLocation(*existing.Location), appServicePlanId)
+	if err != nil {
+		return err
+	}
+	siteConfig := siteEnvelope.SiteProperties.SiteConfig
+	siteConfig.AppSettings = &basicAppSettings
+
+	env := meta.(*clients.Client).Account.Environment
+	storageAccountDomainSuffix, ok := env.Storage.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine the domain suffix for storage accounts in environment %q: %+v", env.Name, env.Storage)
+	}
+
+	// WEBSITE_VNET_ROUTE_ALL is superseded by a setting in site_config that defaults to false from 2021-02-01
+	appSettings, err := expandLogicAppStandardSettings(d, *storageAccountDomainSuffix, id.SiteName+"-"+id.SlotName)
+	if err != nil {
+		return fmt.Errorf("expanding `app_settings`: %+v", err)
+	}
+	if vnetRouteAll, ok := appSettings["WEBSITE_VNET_ROUTE_ALL"]; ok {
+		if !d.HasChange("site_config.0.vnet_route_all_enabled") {
+			vnetRouteAllEnabled, _ := strconv.ParseBool(*vnetRouteAll)
+			siteConfig.VnetRouteAllEnabled = &vnetRouteAllEnabled
+		}
+	}
+
+	if d.HasChange("virtual_network_subnet_id") {
+		subnetId := d.Get("virtual_network_subnet_id").(string)
+		if subnetId == "" {
+			if _, err := client.DeleteSwiftVirtualNetworkSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName); err != nil {
+				return fmt.Errorf("removing `virtual_network_subnet_id` association for %s: %+v", *id, err)
+			}
+			var empty *string
+			siteEnvelope.SiteProperties.VirtualNetworkSubnetID = empty
+		} else {
+			siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(subnetId)
+		}
+	}
+
+	future, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, *siteEnvelope, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	if d.HasChange("site_config") { // update siteConfig before appSettings in case the appSettings get covered by basicAppSettings
+		siteConfigResource := web.SiteConfigResource{
+			SiteConfig: siteConfig,
+		}
+
+		if _, err := client.CreateOrUpdateConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, siteConfigResource, id.SlotName); err != nil {
+			return fmt.Errorf("updating Configuration for %s: %+v", *id, err)
+		}
+	}
+
+	settings := web.StringDictionary{
+		Properties: appSettings,
+	}
+
+	if _, err = client.UpdateApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, settings, id.SlotName); err != nil {
+		return fmt.Errorf("updating Application Settings for %s: %+v", *id, err)
+	}
+
+	if d.HasChange("connection_string") {
+		properties := web.ConnectionStringDictionary{
+			Properties: expandLogicAppStandardConnectionStrings(d),
+		}
+
+		if _, err := client.UpdateConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, properties, id.SlotName); err != nil {
+			return fmt.Errorf("updating Connection Strings for %s: %+v", *id, err)
+		}
+	}
+
+	return resourceLogicAppStandardSlotRead(d, meta)
+}
+
+func resourceLogicAppStandardSlotRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardSlotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("listing application settings for %s: %+v", *id, err)
+	}
+
+	connectionStringsResp, err := client.ListConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("listing connection strings for %s: %+v", *id, err)
+	}
+
+	siteCredFuture, err := client.ListPublishingCredentialsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("listing publishing credentials for %s: %+v", *id, err)
+	}
+	if err = siteCredFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting to list the publishing credentials for %s: %+v", *id, err)
+	}
+	siteCredResp, err := siteCredFuture.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the publishing credentials for %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SlotName)
+	d.Set("logic_app_id", parse.NewLogicAppStandardID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID())
+	d.Set("kind", resp.Kind)
+
+	if props := resp.SiteProperties; props != nil {
+		servicePlanId, err := commonids.ParseAppServicePlanIDInsensitively(*props.ServerFarmID)
+		if err != nil {
+			return err
+		}
+		d.Set("app_service_plan_id", servicePlanId.ID())
+		d.Set("enabled", props.Enabled)
+		d.Set("default_hostname", props.DefaultHostName)
+		d.Set("https_only", props.HTTPSOnly)
+		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
+		d.Set("possible_outbound_ip_addresses", props.PossibleOutboundIPAddresses)
+		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
+		d.Set("custom_domain_verification_id", props.CustomDomainVerificationID)
+		d.Set("virtual_network_subnet_id", props.VirtualNetworkSubnetID)
+
+		clientCertMode := ""
+		if props.ClientCertEnabled != nil && *props.ClientCertEnabled {
+			clientCertMode = string(props.ClientCertMode)
+		}
+		d.Set("client_certificate_mode", clientCertMode)
+	}
+
+	if err = d.Set("connection_string", flattenLogicAppStandardConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return err
+	}
+
+	if err := setLogicAppStandardAppSettings(d, flattenLogicAppStandardAppSettings(appSettingsResp.Properties)); err != nil {
+		return err
+	}
+
+	identity, err := flattenLogicAppStandardIdentity(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %s", err)
+	}
+
+	configResp, err := client.GetConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		return fmt.Errorf("retrieving the configuration for %s: %+v", *id, err)
+	}
+
+	if err = d.Set("site_config", flattenLogicAppStandardSiteConfig(configResp.SiteConfig)); err != nil {
+		return err
+	}
+
+	if err = d.Set("site_credential", flattenLogicAppStandardSiteCredential(siteCredResp.UserProperties)); err != nil {
+		return err
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceLogicAppStandardSlotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LogicAppStandardSlotID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	if _, err := client.DeleteSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// expandLogicAppStandardSlot returns the Site payload for a Logic App Standard Slot along with the App Settings which
+// are managed by the resource rather than through `app_settings`
+func expandLogicAppStandardSlot(d *pluginsdk.ResourceData, meta interface{}, id parse.LogicAppStandardSlotId, location, appServicePlanId string) (*web.Site, []web.NameValuePair, error) {
+	env := meta.(*clients.Client).Account.Environment
+	storageAccountDomainSuffix, ok := env.Storage.DomainSuffix()
+	if !ok {
+		return nil, nil, fmt.Errorf("could not determine the domain suffix for storage accounts in environment %q: %+v", env.Name, env.Storage)
+	}
+
+	// the content share defaults to one per slot, since sharing the content of the Logic App would overwrite its workflows
+	basicAppSettings, err := getBasicLogicAppSettings(d, *storageAccountDomainSuffix, id.SiteName+"-"+id.SlotName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	siteConfig, err := expandLogicAppStandardSiteConfig(d)
+	if err != nil {
+		return nil, nil, fmt.Errorf("expanding `site_config`: %+v", err)
+	}
+
+	kind := "functionapp,workflowapp"
+	if siteConfig.LinuxFxVersion != nil && len(*siteConfig.LinuxFxVersion) > 0 {
+		kind = "functionapp,linux,container,workflowapp"
+	}
+
+	clientCertMode := d.Get("client_certificate_mode").(string)
+
+	siteEnvelope := web.Site{
+		Kind:     &kind,
+		Location: utils.String(location),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID:          utils.String(appServicePlanId),
+			Enabled:               utils.Bool(d.Get("enabled").(bool)),
+			ClientAffinityEnabled: utils.Bool(d.Get("client_affinity_enabled").(bool)),
+			ClientCertEnabled:     utils.Bool(clientCertMode != ""),
+			HTTPSOnly:             utils.Bool(d.Get("https_only").(bool)),
+			SiteConfig:            &siteConfig,
+		},
+	}
+
+	if clientCertMode != "" {
+		siteEnvelope.SiteProperties.ClientCertMode = web.ClientCertMode(clientCertMode)
+	}
+
+	if _, ok := d.GetOk("identity"); ok {
+		appServiceIdentity, err := expandLogicAppStandardIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return nil, nil, fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		siteEnvelope.Identity = appServiceIdentity
+	}
+
+	return &siteEnvelope, basicAppSettings, nil
+}
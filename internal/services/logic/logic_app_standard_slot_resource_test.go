@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogicAppStandardSlotResource struct{}
+
+func TestAccLogicAppStandardSlot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_slot", "test")
+	r := LogicAppStandardSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("functionapp,workflowapp"),
+				check.That(data.ResourceName).Key("default_hostname").Exists(),
+				check.That(data.ResourceName).Key("storage_account_share_name").HasValue(fmt.Sprintf("acctest-%d-func-staging-content", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardSlot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_slot", "test")
+	r := LogicAppStandardSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogicAppStandardSlot_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_slot", "test")
+	r := LogicAppStandardSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_settings.hello").HasValue("world"),
+				check.That(data.ResourceName).Key("site_config.0.runtime_scale_monitoring_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardSlot_contentOverVnetRequiresShare(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_slot", "test")
+	r := LogicAppStandardSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.contentOverVnetWithoutShare(data),
+			ExpectError: regexp.MustCompile("`storage_account_share_name` must be specified"),
+		},
+	})
+}
+
+func (r LogicAppStandardSlotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LogicAppStandardSlotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Web.AppServicesClient.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r LogicAppStandardSlotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_slot" "test" {
+  name                       = "staging"
+  logic_app_id               = azurerm_logic_app_standard.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+}
+`, LogicAppStandardResource{}.basic(data))
+}
+
+func (r LogicAppStandardSlotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_slot" "import" {
+  name                       = azurerm_logic_app_standard_slot.test.name
+  logic_app_id               = azurerm_logic_app_standard_slot.test.logic_app_id
+  storage_account_name       = azurerm_logic_app_standard_slot.test.storage_account_name
+  storage_account_access_key = azurerm_logic_app_standard_slot.test.storage_account_access_key
+}
+`, r.basic(data))
+}
+
+func (r LogicAppStandardSlotResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_slot" "test" {
+  name                       = "staging"
+  logic_app_id               = azurerm_logic_app_standard.test.id
+  app_service_plan_id        = azurerm_app_service_plan.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+  https_only                 = true
+
+  app_settings = {
+    "hello" = "world"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  site_config {
+    runtime_scale_monitoring_enabled = true
+    pre_warmed_instance_count        = 1
+  }
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, LogicAppStandardResource{}.basic(data))
+}
+
+func (r LogicAppStandardSlotResource) contentOverVnetWithoutShare(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_slot" "test" {
+  name                       = "staging"
+  logic_app_id               = azurerm_logic_app_standard.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  app_settings = {
+    "WEBSITE_CONTENTOVERVNET" = "1"
+  }
+}
+`, LogicAppStandardResource{}.basic(data))
+}
@@ -57,6 +57,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_logic_app_trigger_recurrence":                      resourceLogicAppTriggerRecurrence(),
 		"azurerm_logic_app_workflow":                                resourceLogicAppWorkflow(),
 		"azurerm_logic_app_standard":                                resourceLogicAppStandard(),
+		"azurerm_logic_app_standard_active_slot":                    resourceLogicAppStandardActiveSlot(),
+		"azurerm_logic_app_standard_slot":                           resourceLogicAppStandardSlot(),
 	}
 
 	if !features.FourPointOhBeta() {
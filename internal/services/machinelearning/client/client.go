@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/machinelearningcomputes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/managednetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -15,6 +16,7 @@ import (
 type Client struct {
 	Datastore               *datastore.DatastoreClient
 	MachineLearningComputes *machinelearningcomputes.MachineLearningComputesClient
+	ManagedNetwork          *managednetwork.ManagedNetworkClient
 	Workspaces              *workspaces.WorkspacesClient
 }
 
@@ -37,8 +39,15 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(computesClient.Client, o.Authorizers.ResourceManager)
 
+	managedNetworkClient, err := managednetwork.NewManagedNetworkClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ManagedNetwork client: %+v", err)
+	}
+	o.Configure(managedNetworkClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		MachineLearningComputes: computesClient,
+		ManagedNetwork:          managedNetworkClient,
 		Datastore:               datastoreClient,
 		Workspaces:              workspacesClient,
 	}, nil
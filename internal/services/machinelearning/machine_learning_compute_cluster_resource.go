@@ -28,6 +28,7 @@ func resourceComputeCluster() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceComputeClusterCreate,
 		Read:   resourceComputeClusterRead,
+		Update: resourceComputeClusterUpdate,
 		Delete: resourceComputeClusterDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -38,6 +39,7 @@ func resourceComputeCluster() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -75,24 +77,20 @@ func resourceComputeCluster() *pluginsdk.Resource {
 			"scale_settings": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"max_node_count": {
 							Type:     pluginsdk.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"min_node_count": {
 							Type:     pluginsdk.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"scale_down_nodes_after_idle_duration": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -366,6 +364,34 @@ func resourceComputeClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 	return tags.FlattenAndSet(d, computeResource.Model.Tags)
 }
 
+func resourceComputeClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.MachineLearningComputes
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := machinelearningcomputes.ParseComputeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// NOTE: the PATCH API only supports updating the scale settings, all other properties are ForceNew
+	if d.HasChange("scale_settings") {
+		payload := machinelearningcomputes.ClusterUpdateParameters{
+			Properties: &machinelearningcomputes.ClusterUpdateProperties{
+				Properties: &machinelearningcomputes.ScaleSettingsInformation{
+					ScaleSettings: expandScaleSettings(d.Get("scale_settings").([]interface{})),
+				},
+			},
+		}
+
+		if err := client.ComputeUpdateThenPoll(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceComputeClusterRead(d, meta)
+}
+
 func resourceComputeClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MachineLearning.MachineLearningComputes
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -49,6 +49,37 @@ func TestAccMachineLearningDataStoreDataLakeGen2_spn(t *testing.T) {
 	})
 }
 
+func TestAccMachineLearningDataStoreDataLakeGen2_serviceDataIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_datastore_datalake_gen2", "test")
+	r := MachineLearningDataStoreDataLakeGen2{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataLakeGen2Basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_data_identity").HasValue("None"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dataLakeGen2ServiceDataIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_data_identity").HasValue("WorkspaceSystemAssignedIdentity"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dataLakeGen2Basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMachineLearningDataStoreDataLakeGen2_Update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_machine_learning_datastore_datalake_gen2", "test")
 	r := MachineLearningDataStoreDataLakeGen2{}
@@ -123,6 +154,34 @@ resource "azurerm_machine_learning_datastore_datalake_gen2" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r MachineLearningDataStoreDataLakeGen2) dataLakeGen2ServiceDataIdentity(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer%[2]d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Reader"
+  principal_id         = azurerm_machine_learning_workspace.test.identity[0].principal_id
+}
+
+resource "azurerm_machine_learning_datastore_datalake_gen2" "test" {
+  name                  = "accdatastore%[2]d"
+  workspace_id          = azurerm_machine_learning_workspace.test.id
+  storage_container_id  = azurerm_storage_container.test.resource_manager_id
+  service_data_identity = "WorkspaceSystemAssignedIdentity"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, template, data.RandomInteger)
+}
+
 func (r MachineLearningDataStoreDataLakeGen2) dataLakeGen2Spn(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningDataStoresDataSource struct{}
+
+var _ sdk.DataSource = MachineLearningDataStoresDataSource{}
+
+type MachineLearningDataStoresDataSourceModel struct {
+	WorkspaceID string                     `tfschema:"workspace_id"`
+	DataStores  []MachineLearningDataStore `tfschema:"datastores"`
+}
+
+type MachineLearningDataStore struct {
+	ID                   string            `tfschema:"id"`
+	Name                 string            `tfschema:"name"`
+	Type                 string            `tfschema:"type"`
+	Description          string            `tfschema:"description"`
+	IsDefault            bool              `tfschema:"is_default"`
+	StorageAccountName   string            `tfschema:"storage_account_name"`
+	StorageContainerName string            `tfschema:"storage_container_name"`
+	StorageFileShareName string            `tfschema:"storage_fileshare_name"`
+	Tags                 map[string]string `tfschema:"tags"`
+}
+
+func (d MachineLearningDataStoresDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: datastore.ValidateWorkspaceID,
+		},
+	}
+}
+
+func (d MachineLearningDataStoresDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"datastores": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"is_default": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"storage_account_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"storage_container_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"storage_fileshare_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tags": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d MachineLearningDataStoresDataSource) ModelObject() interface{} {
+	return &MachineLearningDataStoresDataSourceModel{}
+}
+
+func (d MachineLearningDataStoresDataSource) ResourceType() string {
+	return "azurerm_machine_learning_datastores"
+}
+
+func (d MachineLearningDataStoresDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Datastore
+
+			var state MachineLearningDataStoresDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := datastore.ParseWorkspaceID(state.WorkspaceID)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListComplete(ctx, *workspaceId, datastore.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Data Stores in %s: %+v", *workspaceId, err)
+			}
+
+			state.DataStores = flattenMachineLearningDataStores(resp.Items)
+
+			metadata.SetID(workspaceId)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenMachineLearningDataStores(input []datastore.DatastoreResource) []MachineLearningDataStore {
+	output := make([]MachineLearningDataStore, 0)
+
+	for _, item := range input {
+		ds := MachineLearningDataStore{
+			ID:   pointer.From(item.Id),
+			Name: pointer.From(item.Name),
+		}
+
+		switch props := item.Properties.(type) {
+		case datastore.AzureBlobDatastore:
+			ds.Type = string(datastore.DatastoreTypeAzureBlob)
+			ds.Description = pointer.From(props.Description)
+			ds.IsDefault = pointer.From(props.IsDefault)
+			ds.StorageAccountName = pointer.From(props.AccountName)
+			ds.StorageContainerName = pointer.From(props.ContainerName)
+			ds.Tags = pointer.From(props.Tags)
+		case datastore.AzureDataLakeGen2Datastore:
+			ds.Type = string(datastore.DatastoreTypeAzureDataLakeGenTwo)
+			ds.Description = pointer.From(props.Description)
+			ds.IsDefault = pointer.From(props.IsDefault)
+			ds.StorageAccountName = props.AccountName
+			ds.StorageContainerName = props.Filesystem
+			ds.Tags = pointer.From(props.Tags)
+		case datastore.AzureFileDatastore:
+			ds.Type = string(datastore.DatastoreTypeAzureFile)
+			ds.Description = pointer.From(props.Description)
+			ds.IsDefault = pointer.From(props.IsDefault)
+			ds.StorageAccountName = props.AccountName
+			ds.StorageFileShareName = props.FileShareName
+			ds.Tags = pointer.From(props.Tags)
+		case datastore.AzureDataLakeGen1Datastore:
+			ds.Type = string(datastore.DatastoreTypeAzureDataLakeGenOne)
+			ds.Description = pointer.From(props.Description)
+			ds.IsDefault = pointer.From(props.IsDefault)
+			ds.StorageAccountName = props.StoreName
+			ds.Tags = pointer.From(props.Tags)
+		case datastore.OneLakeDatastore:
+			ds.Type = string(datastore.DatastoreTypeOneLake)
+			ds.Description = pointer.From(props.Description)
+			ds.IsDefault = pointer.From(props.IsDefault)
+			ds.Tags = pointer.From(props.Tags)
+		}
+
+		output = append(output, ds)
+	}
+
+	return output
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MachineLearningDataStoresDataSource struct{}
+
+func TestAccMachineLearningDataStoresDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_machine_learning_datastores", "test")
+	d := MachineLearningDataStoresDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("datastores.#").Exists(),
+				check.That(data.ResourceName).Key("datastores.0.name").Exists(),
+				check.That(data.ResourceName).Key("datastores.0.type").Exists(),
+				check.That(data.ResourceName).Key("datastores.0.storage_account_name").Exists(),
+			),
+		},
+	})
+}
+
+func (d MachineLearningDataStoresDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_machine_learning_datastores" "test" {
+  workspace_id = azurerm_machine_learning_workspace.test.id
+
+  depends_on = [azurerm_machine_learning_datastore_blobstorage.test]
+}
+`, MachineLearningDataStoreBlobStorage{}.blobStorageAccountKey(data))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/managednetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -196,6 +197,12 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(workspaces.PossibleValuesForIsolationMode(), false),
 						},
+
+						"provision_on_creation_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -288,6 +295,12 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 						Computed:     true,
 						ValidateFunc: validation.StringInSlice(workspaces.PossibleValuesForIsolationMode(), false),
 					},
+
+					"provision_on_creation_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 				},
 			},
 		}
@@ -298,6 +311,7 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 
 func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MachineLearning.Workspaces
+	managedNetworkClient := meta.(*clients.Client).MachineLearning.ManagedNetwork
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -400,10 +414,26 @@ func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta inte
 		workspace.Properties.FeatureStoreSettings = featureStore
 	}
 
+	provisionManagedNetwork := false
+	if v := d.Get("managed_network").([]interface{}); len(v) > 0 && v[0] != nil {
+		provisionManagedNetwork = v[0].(map[string]interface{})["provision_on_creation_enabled"].(bool)
+		if provisionManagedNetwork && pointer.From(workspace.Properties.ManagedNetwork.IsolationMode) == workspaces.IsolationModeDisabled {
+			return fmt.Errorf("`provision_on_creation_enabled` can only be set when `isolation_mode` is not `%s`", workspaces.IsolationModeDisabled)
+		}
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, workspace); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	// the Managed Network is otherwise provisioned lazily when the first compute is created
+	if provisionManagedNetwork {
+		managedNetworkId := managednetwork.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+		if err := managedNetworkClient.ProvisionsProvisionManagedNetworkThenPoll(ctx, managedNetworkId, managednetwork.ManagedNetworkProvisionOptions{}); err != nil {
+			return fmt.Errorf("provisioning the Managed Network for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
@@ -487,6 +517,14 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 		payload.Properties.ManagedNetwork = expandMachineLearningWorkspaceManagedNetwork(d.Get("managed_network").([]interface{}))
 	}
 
+	provisionManagedNetwork := false
+	if d.HasChange("managed_network.0.provision_on_creation_enabled") {
+		provisionManagedNetwork = d.Get("managed_network.0.provision_on_creation_enabled").(bool)
+		if provisionManagedNetwork && d.Get("managed_network.0.isolation_mode").(string) == string(workspaces.IsolationModeDisabled) {
+			return fmt.Errorf("`provision_on_creation_enabled` can only be set when `isolation_mode` is not `%s`", workspaces.IsolationModeDisabled)
+		}
+	}
+
 	if d.HasChange("sku_name") {
 		payload.Sku = &workspaces.Sku{
 			Name: d.Get("sku_name").(string),
@@ -526,6 +564,14 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
+	// provisioning an already provisioned Managed Network is a no-op, so this is only done when the flag is switched on
+	if provisionManagedNetwork {
+		managedNetworkId := managednetwork.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+		if err := meta.(*clients.Client).MachineLearning.ManagedNetwork.ProvisionsProvisionManagedNetworkThenPoll(ctx, managedNetworkId, managednetwork.ManagedNetworkProvisionOptions{}); err != nil {
+			return fmt.Errorf("provisioning the Managed Network for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
@@ -589,7 +635,7 @@ func resourceMachineLearningWorkspaceRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("public_network_access_enabled", *props.PublicNetworkAccess == workspaces.PublicNetworkAccessEnabled)
 			d.Set("v1_legacy_mode_enabled", props.V1LegacyMode)
 			d.Set("workspace_id", props.WorkspaceId)
-			d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(props.ManagedNetwork))
+			d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(props.ManagedNetwork, d))
 			d.Set("serverless_compute", flattenMachineLearningWorkspaceServerlessCompute(props.ServerlessComputeSettings))
 
 			kvId, err := commonids.ParseKeyVaultIDInsensitively(*props.KeyVault)
@@ -826,7 +872,7 @@ func expandMachineLearningWorkspaceManagedNetwork(i []interface{}) *workspaces.M
 	}
 }
 
-func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkSettings) *[]interface{} {
+func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkSettings, d *pluginsdk.ResourceData) *[]interface{} {
 	if i == nil {
 		return &[]interface{}{}
 	}
@@ -837,6 +883,9 @@ func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkS
 		out["isolation_mode"] = *i.IsolationMode
 	}
 
+	// the API doesn't return whether the Managed Network was provisioned on creation, so read this back from the config
+	out["provision_on_creation_enabled"] = d.Get("managed_network.0.provision_on_creation_enabled").(bool)
+
 	return &[]interface{}{out}
 }
 
@@ -371,6 +371,46 @@ func TestAccMachineLearningWorkspace_serverlessCompute(t *testing.T) {
 	})
 }
 
+func TestAccMachineLearningWorkspace_managedNetworkProvisionOnCreation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
+	r := WorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedNetworkProvisionOnCreation(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.provision_on_creation_enabled").HasValue("true"),
+			),
+		},
+		// the API doesn't return this value, so it's always `false` once imported
+		data.ImportStep("managed_network.0.provision_on_creation_enabled"),
+	})
+}
+
+func TestAccMachineLearningWorkspace_managedNetworkProvisionOnUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
+	r := WorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedNetworkProvisionOnCreation(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedNetworkProvisionOnCreation(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.provision_on_creation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("managed_network.0.provision_on_creation_enabled"),
+	})
+}
+
 func TestAccMachineLearningWorkspace_serverlessCompute_withoutSubnet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
 	r := WorkspaceResource{}
@@ -442,6 +482,43 @@ resource "azurerm_machine_learning_workspace" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r WorkspaceResource) managedNetworkProvisionOnCreation(data acceptance.TestData, enabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy       = false
+      purge_soft_deleted_keys_on_destroy = false
+    }
+    resource_group {
+      prevent_deletion_if_contains_resources = false
+    }
+  }
+}
+
+%s
+
+resource "azurerm_machine_learning_workspace" "test" {
+  name                    = "acctest-MLW-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  key_vault_id            = azurerm_key_vault.test.id
+  storage_account_id      = azurerm_storage_account.test.id
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  managed_network {
+    isolation_mode                = "AllowInternetOutbound"
+    provision_on_creation_enabled = %t
+  }
+}
+`, template, data.RandomInteger, enabled)
+}
+
 func (r WorkspaceResource) basicUpdated(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
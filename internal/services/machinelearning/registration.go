@@ -48,7 +48,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // DataSources returns the typed DataSources supported by this service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		MachineLearningDataStoresDataSource{},
+	}
 }
 
 // Resources returns the typed Resources supported by this service
@@ -274,9 +274,12 @@ func (MaintenanceDynamicScopeResource) Read() sdk.ResourceFunc {
 					if filter := properties.Filter; filter != nil {
 						filterProp := make([]Filter, 0)
 						tagsListProp := make([]Tag, 0)
-						tagFilterProp := ""
+						tagFilterProp := string(configurationassignments.TagOperatorsAny)
 						if tags := filter.TagSettings; tags != nil {
-							tagFilterProp = string(pointer.From(tags.FilterOperator))
+							// the API omits the operator when no tags are specified, so fall back to the default
+							if tags.FilterOperator != nil {
+								tagFilterProp = string(pointer.From(tags.FilterOperator))
+							}
 							for k, v := range pointer.From(tags.Tags) {
 								tagsListProp = append(tagsListProp, Tag{
 									Tag:    k,
@@ -77,6 +77,30 @@ func TestAccMaintenanceAssignmentDynamicScope_update(t *testing.T) {
 	})
 }
 
+func TestAccMaintenanceAssignmentDynamicScope_tagFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_dynamic_scope", "test")
+	r := MaintenanceDynamicScopeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tagFilterAll(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("filter.0.tag_filter").HasValue("All"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("filter.0.tag_filter").HasValue("Any"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMaintenanceAssignmentDynamicScope_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_dynamic_scope", "test")
 	r := MaintenanceDynamicScopeResource{}
@@ -152,6 +176,37 @@ resource "azurerm_maintenance_assignment_dynamic_scope" "test" {
 `, r.template(data), data.RandomInteger, data.Locations.Primary)
 }
 
+func (r MaintenanceDynamicScopeResource) tagFilterAll(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_maintenance_assignment_dynamic_scope" "test" {
+  name                         = "acctest-complete-%[2]d"
+  maintenance_configuration_id = azurerm_maintenance_configuration.test.id
+
+  filter {
+    os_types        = ["Linux", "Windows"]
+    resource_groups = [azurerm_resource_group.test.name]
+    tag_filter      = "All"
+
+    tags {
+      tag    = "foo"
+      values = ["barbar"]
+    }
+
+    tags {
+      tag    = "environment"
+      values = ["test", "staging"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MaintenanceDynamicScopeResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
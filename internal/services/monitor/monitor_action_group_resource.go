@@ -13,7 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2022-08-08/automationaccount"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-01-01/actiongroupsapis"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -755,7 +755,9 @@ func expandMonitorActionGroupWebHookReceiver(tenantId string, v []interface{}) *
 			secureWebhook := v[0].(map[string]interface{})
 			receiver.UseAadAuth = utils.Bool(true)
 			receiver.ObjectId = utils.String(secureWebhook["object_id"].(string))
-			receiver.IdentifierUri = utils.String(secureWebhook["identifier_uri"].(string))
+			if v := secureWebhook["identifier_uri"].(string); v != "" {
+				receiver.IdentifierUri = utils.String(v)
+			}
 			if v := secureWebhook["tenant_id"].(string); v != "" {
 				receiver.TenantId = utils.String(v)
 			} else {
@@ -75,7 +75,10 @@ type ScheduledQueryRulesAlertV2FailingPeriodsModel struct {
 
 type ScheduledQueryRulesAlertV2Resource struct{}
 
-var _ sdk.ResourceWithUpdate = ScheduledQueryRulesAlertV2Resource{}
+var (
+	_ sdk.ResourceWithUpdate        = ScheduledQueryRulesAlertV2Resource{}
+	_ sdk.ResourceWithCustomizeDiff = ScheduledQueryRulesAlertV2Resource{}
+)
 
 func (r ScheduledQueryRulesAlertV2Resource) ResourceType() string {
 	return "azurerm_monitor_scheduled_query_rules_alert_v2"
@@ -239,7 +242,6 @@ func (r ScheduledQueryRulesAlertV2Resource) Arguments() map[string]*pluginsdk.Sc
 			Type:     pluginsdk.TypeList,
 			Required: true,
 			MinItems: 1,
-			MaxItems: 1,
 			ForceNew: true,
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
@@ -409,6 +411,23 @@ func (r ScheduledQueryRulesAlertV2Resource) Attributes() map[string]*pluginsdk.S
 	}
 }
 
+func (r ScheduledQueryRulesAlertV2Resource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			scopes := metadata.ResourceDiff.Get("scopes").([]interface{})
+			targetResourceTypes := metadata.ResourceDiff.Get("target_resource_types").([]interface{})
+
+			// a rule spanning several resources fires a separate alert per resource, so the API needs to know which resource types to split on
+			if len(scopes) > 1 && len(targetResourceTypes) == 0 && metadata.ResourceDiff.NewValueKnown("target_resource_types") {
+				return fmt.Errorf("`target_resource_types` must be specified when more than one resource ID is specified in `scopes`")
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ScheduledQueryRulesAlertV2Resource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -6,6 +6,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -152,6 +153,32 @@ func TestAccMonitorScheduledQueryRulesAlertV2_identityUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMonitorScheduledQueryRulesAlertV2_multipleScopes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleScopes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorScheduledQueryRulesAlertV2_multipleScopesWithoutTargetResourceTypes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.multipleScopesWithoutTargetResourceTypes(data),
+			ExpectError: regexp.MustCompile("`target_resource_types` must be specified"),
+		},
+	})
+}
+
 func (r MonitorScheduledQueryRulesAlertV2Resource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(state.ID)
 	if err != nil {
@@ -434,3 +461,84 @@ resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
 }
 `, template, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) multipleScopes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                  = "acctest-isqr-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = "%s"
+  evaluation_frequency  = "PT5M"
+  window_duration       = "PT5M"
+  scopes                = [azurerm_resource_group.test.id, azurerm_resource_group.second.id]
+  target_resource_types = ["Microsoft.Compute/virtualMachines"]
+  severity              = 3
+  criteria {
+    query                   = <<-QUERY
+      Heartbeat
+	    | summarize LastHeartbeat=max(TimeGenerated) by _ResourceId
+	  QUERY
+    time_aggregation_method = "Count"
+    threshold               = 1.0
+    operator                = "LessThan"
+  }
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+  depends_on = [azurerm_role_assignment.scope, azurerm_role_assignment.second]
+}
+`, r.multipleScopesTemplate(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) multipleScopesWithoutTargetResourceTypes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                 = "acctest-isqr-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  location             = "%s"
+  evaluation_frequency = "PT5M"
+  window_duration      = "PT5M"
+  scopes               = [azurerm_resource_group.test.id, azurerm_resource_group.second.id]
+  severity             = 3
+  criteria {
+    query                   = <<-QUERY
+      Heartbeat
+	    | summarize LastHeartbeat=max(TimeGenerated) by _ResourceId
+	  QUERY
+    time_aggregation_method = "Count"
+    threshold               = 1.0
+    operator                = "LessThan"
+  }
+}
+`, r.multipleScopesTemplate(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) multipleScopesTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "second" {
+  name     = "acctest-rg-second-%d"
+  location = "%s"
+}
+
+resource "azurerm_role_assignment" "scope" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Reader"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_role_assignment" "second" {
+  scope                = azurerm_resource_group.second.id
+  role_definition_name = "Reader"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DataCollectionRuleTransformKql performs a basic syntax check of a Data Collection Rule transformation,
+// the full KQL grammar is validated by the API - this only catches the common mistakes at plan time:
+// the `source` table isn't referenced, brackets or string literals aren't closed, or a pipe segment is empty.
+// String literals (including verbatim `@'...'` and multi-line ```...``` strings) and `//` comments are skipped.
+func DataCollectionRuleTransformKql(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	query := strings.TrimSpace(v)
+	if query == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	closing := map[byte]byte{')': '(', ']': '[', '}': '{'}
+	stack := make([]byte, 0)
+	segments := make([]string, 0)
+	segmentStart := 0
+
+	// code holds the query with string literals and comments blanked out
+	code := make([]byte, 0, len(query))
+
+	for idx := 0; idx < len(query); idx++ {
+		c := query[idx]
+
+		switch {
+		case strings.HasPrefix(query[idx:], "//"):
+			end := strings.IndexByte(query[idx:], '\n')
+			if end == -1 {
+				end = len(query) - idx
+			}
+			idx += end - 1
+			code = append(code, ' ')
+			continue
+
+		case strings.HasPrefix(query[idx:], "```"):
+			end := strings.Index(query[idx+3:], "```")
+			if end == -1 {
+				errors = append(errors, fmt.Errorf("%q contains an unterminated string literal", k))
+				return
+			}
+			idx += end + 5
+			code = append(code, ' ')
+			continue
+
+		case (c == '@' || c == 'h' || c == 'H') && idx+1 < len(query) && (query[idx+1] == '"' || query[idx+1] == '\'') && (c == '@' || isKqlTokenBoundary(query, idx)):
+			// verbatim (`@'...'`) and obfuscated (`h'...'`) string literals
+			end, ok := findKqlStringEnd(query, idx+1, c == '@')
+			if !ok {
+				errors = append(errors, fmt.Errorf("%q contains an unterminated string literal", k))
+				return
+			}
+			idx = end
+			code = append(code, ' ')
+			continue
+
+		case c == '"' || c == '\'':
+			end, ok := findKqlStringEnd(query, idx, false)
+			if !ok {
+				errors = append(errors, fmt.Errorf("%q contains an unterminated string literal", k))
+				return
+			}
+			idx = end
+			code = append(code, ' ')
+			continue
+
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, c)
+
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) == 0 || stack[len(stack)-1] != closing[c] {
+				errors = append(errors, fmt.Errorf("%q contains an unexpected %q at position %d", k, string(c), idx))
+				return
+			}
+			stack = stack[:len(stack)-1]
+
+		case c == '|':
+			if len(stack) == 0 {
+				segments = append(segments, query[segmentStart:idx])
+				segmentStart = idx + 1
+			}
+		}
+
+		code = append(code, c)
+	}
+
+	if len(stack) > 0 {
+		errors = append(errors, fmt.Errorf("%q contains an unclosed %q", k, string(stack[len(stack)-1])))
+		return
+	}
+
+	segments = append(segments, query[segmentStart:])
+	for idx, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			errors = append(errors, fmt.Errorf("%q contains an empty pipe segment at position %d", k, idx))
+			return
+		}
+	}
+
+	if !regexp.MustCompile(`\bsource\b`).Match(code) {
+		errors = append(errors, fmt.Errorf("%q must reference the `source` table, got %q", k, v))
+		return
+	}
+
+	return
+}
+
+// findKqlStringEnd returns the index of the closing quote of the string literal starting at `start`.
+// Verbatim strings don't support escape sequences, a quote is escaped by doubling it instead.
+func findKqlStringEnd(query string, start int, verbatim bool) (int, bool) {
+	quote := query[start]
+	for idx := start + 1; idx < len(query); idx++ {
+		switch c := query[idx]; {
+		case c == '\n':
+			return 0, false
+		case !verbatim && c == '\\':
+			idx++
+		case c == quote:
+			if verbatim && idx+1 < len(query) && query[idx+1] == quote {
+				idx++
+				continue
+			}
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+func isKqlTokenBoundary(query string, idx int) bool {
+	if idx == 0 {
+		return true
+	}
+
+	prev := query[idx-1]
+	return !(prev == '_' || (prev >= 'a' && prev <= 'z') || (prev >= 'A' && prev <= 'Z') || (prev >= '0' && prev <= '9'))
+}
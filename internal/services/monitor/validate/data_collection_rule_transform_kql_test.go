@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestDataCollectionRuleTransformKql(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "source",
+			expected: true,
+		},
+		{
+			input:    "source | project TimeGenerated = Time, Computer, Message = AdditionalContext",
+			expected: true,
+		},
+		{
+			input:    "source | where Message has \"error|warning\" | extend Level = tostring(parse_json(Properties)[\"level\"])",
+			expected: true,
+		},
+		{
+			input:    "Syslog | project TimeGenerated",
+			expected: false,
+		},
+		{
+			input:    "sources | project TimeGenerated",
+			expected: false,
+		},
+		{
+			input:    "source | project TimeGenerated |",
+			expected: false,
+		},
+		{
+			input:    "source || project TimeGenerated",
+			expected: false,
+		},
+		{
+			input:    "source | extend Level = tostring(Properties[\"level\"]",
+			expected: false,
+		},
+		{
+			input:    "source | extend Level = tostring(Properties[\"level\")]",
+			expected: false,
+		},
+		{
+			input:    "source | where Message == 'unterminated",
+			expected: false,
+		},
+		{
+			input:    "source | where Message == 'it\\'s fine'",
+			expected: true,
+		},
+		{
+			input:    "let threshold = 10;\nsource | where Count > threshold",
+			expected: true,
+		},
+		{
+			input:    "// drop the debug logs\nsource | where Level != \"Debug\"",
+			expected: true,
+		},
+		{
+			input:    "source\n// keep only errors (and warnings\n| where Level in (\"Error\", \"Warning\")",
+			expected: true,
+		},
+		{
+			input:    "source | where Path startswith @'C:\\Windows\\' | project TimeGenerated",
+			expected: true,
+		},
+		{
+			input:    "source | where Message == @'it''s a [verbatim ( string' | project TimeGenerated",
+			expected: true,
+		},
+		{
+			input:    "source | extend Pattern = ```multi-line | string (\n]``` | project TimeGenerated",
+			expected: true,
+		},
+		{
+			input:    "let threshold = 10;\nSyslog | where Count > threshold",
+			expected: false,
+		},
+		{
+			input:    "// source\nSyslog | project TimeGenerated",
+			expected: false,
+		},
+		{
+			input:    "Syslog | where Message == 'source' | project TimeGenerated",
+			expected: false,
+		},
+		{
+			input:    "source | where Message == @'unterminated",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DataCollectionRuleTransformKql(v.input, "transform_kql")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %+v", v.expected, actual, v.input, errors)
+		}
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/serversecurityalertpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/transparentdataencryptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/databasesqlvulnerabilityassessmentrulebaselines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/sqlvulnerabilityassessmentssettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/availabilitygrouplisteners"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachinegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
@@ -31,6 +33,7 @@ type Client struct {
 	BackupShortTermRetentionPoliciesClient             *backupshorttermretentionpolicies.BackupShortTermRetentionPoliciesClient
 	DatabaseExtendedBlobAuditingPoliciesClient         *sql.ExtendedDatabaseBlobAuditingPoliciesClient
 	DatabaseSecurityAlertPoliciesClient                *databasesecurityalertpolicies.DatabaseSecurityAlertPoliciesClient
+	DatabaseSqlVulnerabilityAssessmentBaselinesClient  *databasesqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselinesClient
 	DatabaseVulnerabilityAssessmentRuleBaselinesClient *sql.DatabaseVulnerabilityAssessmentRuleBaselinesClient
 	DatabasesClient                                    *databases.DatabasesClient
 	ElasticPoolsClient                                 *elasticpools.ElasticPoolsClient
@@ -53,6 +56,7 @@ type Client struct {
 	ServerDevOpsAuditSettingsClient                    *sql.ServerDevOpsAuditSettingsClient
 	ServerKeysClient                                   *sql.ServerKeysClient
 	ServerSecurityAlertPoliciesClient                  *serversecurityalertpolicies.ServerSecurityAlertPoliciesClient
+	ServerSqlVulnerabilityAssessmentsClient            *sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentsSettingsClient
 	LegacyServerSecurityAlertPoliciesClient            *sql.ServerSecurityAlertPoliciesClient
 	ServerVulnerabilityAssessmentsClient               *sql.ServerVulnerabilityAssessmentsClient
 	ServersClient                                      *servers.ServersClient
@@ -212,6 +216,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(virtualMachineGroupsClient.Client, o.Authorizers.ResourceManager)
 
+	databaseSqlVulnerabilityAssessmentBaselinesClient, err := databasesqlvulnerabilityassessmentrulebaselines.NewDatabaseSqlVulnerabilityAssessmentRuleBaselinesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Database Sql Vulnerability Assessment Baselines Client: %+v", err)
+	}
+	o.Configure(databaseSqlVulnerabilityAssessmentBaselinesClient.Client, o.Authorizers.ResourceManager)
+
+	serverSqlVulnerabilityAssessmentsClient, err := sqlvulnerabilityassessmentssettings.NewSqlVulnerabilityAssessmentsSettingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Server Sql Vulnerability Assessments Client: %+v", err)
+	}
+	o.Configure(serverSqlVulnerabilityAssessmentsClient.Client, o.Authorizers.ResourceManager)
+
 	virtualNetworkRulesClient := sql.NewVirtualNetworkRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&virtualNetworkRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -254,5 +270,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ServerSecurityAlertPoliciesClient:      serverSecurityAlertPoliciesClient,
 		TransparentDataEncryptionsClient:       transparentDataEncryptionsClient,
 		ServersClient:                          serversClient,
+
+		// 2023-05-01-preview Clients
+		DatabaseSqlVulnerabilityAssessmentBaselinesClient: databaseSqlVulnerabilityAssessmentBaselinesClient,
+		ServerSqlVulnerabilityAssessmentsClient:           serverSqlVulnerabilityAssessmentsClient,
 	}, nil
 }
@@ -234,7 +234,7 @@ var getTierFromName = map[string]string{
 	"bc_gen4":      "BusinessCritical",
 	"bc_gen5":      "BusinessCritical",
 	"bc_dc":        "BusinessCritical",
-	"hs_gen5":      "HyperScale",
+	"hs_gen5":      "Hyperscale",
 }
 
 func MSSQLElasticPoolValidateSKU(diff *pluginsdk.ResourceDiff) error {
@@ -310,7 +310,8 @@ func nameTierIsValid(s sku) bool {
 		strings.EqualFold(s.Name, "StandardPool") && !strings.EqualFold(s.Tier, "Standard") ||
 		strings.EqualFold(s.Name, "PremiumPool") && !strings.EqualFold(s.Tier, "Premium") ||
 		strings.HasPrefix(strings.ToLower(s.Name), "gp_") && !strings.EqualFold(s.Tier, "GeneralPurpose") ||
-		strings.HasPrefix(strings.ToLower(s.Name), "bc_") && !strings.EqualFold(s.Tier, "BusinessCritical") {
+		strings.HasPrefix(strings.ToLower(s.Name), "bc_") && !strings.EqualFold(s.Tier, "BusinessCritical") ||
+		strings.HasPrefix(strings.ToLower(s.Name), "hs_") && !strings.EqualFold(s.Tier, "Hyperscale") {
 		return false
 	}
 
@@ -448,5 +449,23 @@ func doVCoreSKUValidation(s sku) error {
 		return fmt.Errorf("perDatabaseSettings 'maxCapacity'(%d) must be greater than or equal to the perDatabaseSettings 'minCapacity'(%d) value", int(s.MaxCapacity), int(s.MinCapacity))
 	}
 
+	if strings.EqualFold(s.Tier, "Hyperscale") {
+		return doHyperscaleSKUValidation(s)
+	}
+
+	return nil
+}
+
+// doHyperscaleSKUValidation: Hyperscale elastic pools only allow a fractional vCore 'minCapacity' of 0.25 or 0.5,
+// all other per database settings must be whole vCores
+func doHyperscaleSKUValidation(s sku) error {
+	if s.MinCapacity != math.Trunc(s.MinCapacity) && s.MinCapacity != 0.25 && s.MinCapacity != 0.5 {
+		return fmt.Errorf("service tier '%s' perDatabaseSettings 'minCapacity' must be 0, 0.25, 0.5 or a whole number of vCores, got %g", s.Tier, s.MinCapacity)
+	}
+
+	if s.MaxCapacity < 1 || s.MaxCapacity != math.Trunc(s.MaxCapacity) {
+		return fmt.Errorf("service tier '%s' perDatabaseSettings 'maxCapacity' must be a whole number of vCores greater than or equal to 1, got %g", s.Tier, s.MaxCapacity)
+	}
+
 	return nil
 }
@@ -112,8 +112,38 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				}
 
 				return nil
-			}),
+			},
+			msSqlDatabaseHyperscaleReplicaDiff,
+		),
+	}
+}
+
+// msSqlDatabaseHyperscaleReplicaDiff validates the Hyperscale specific replica settings at plan time, Hyperscale databases
+// scale reads through High Availability replicas (`read_replica_count`) and Named replicas rather than `read_scale`.
+func msSqlDatabaseHyperscaleReplicaDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	createMode := d.Get("create_mode").(string)
+	secondaryType := d.Get("secondary_type").(string)
+
+	// `secondary_type` is ForceNew, so this only needs checking when the database is being created
+	if d.Id() == "" && secondaryType == string(databases.SecondaryTypeNamed) && createMode != string(databases.CreateModeSecondary) {
+		return fmt.Errorf("`create_mode` must be %q when `secondary_type` is %q", string(databases.CreateModeSecondary), string(databases.SecondaryTypeNamed))
+	}
+
+	skuName := d.Get("sku_name").(string)
+	isHyperscale := strings.HasPrefix(strings.ToLower(skuName), "hs_")
+
+	// `read_scale` is Computed, so only the configured value is validated
+	if v := d.GetRawConfig().AsValueMap()["read_scale"]; v.IsKnown() && !v.IsNull() && v.True() && (isHyperscale || secondaryType == string(databases.SecondaryTypeNamed)) {
+		return fmt.Errorf("`read_scale` is not supported for Hyperscale databases, `read_replica_count` should be used instead")
+	}
+
+	if isHyperscale && d.Get("zone_redundant").(bool) {
+		if v := d.GetRawConfig().AsValueMap()["read_replica_count"]; v.IsKnown() && !v.IsNull() && d.Get("read_replica_count").(int) == 0 {
+			return fmt.Errorf("`read_replica_count` must be at least `1` when `zone_redundant` is enabled for Hyperscale databases")
+		}
 	}
+
+	return nil
 }
 
 func resourceMsSqlDatabaseImporter(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
@@ -959,13 +959,37 @@ func TestAccMsSqlDatabase_namedReplicationZoneRedundant(t *testing.T) {
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.namedReplicationZoneRedundant(data),
+			Config: r.namedReplicationZoneRedundant(data, 1),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("enclave_type").IsEmpty(),
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.namedReplicationZoneRedundant(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_mssql_database.secondary").Key("read_replica_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabase_hyperscaleReplicaValidation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.namedReplicationWithoutSecondaryCreateMode(data),
+			ExpectError: regexp.MustCompile("`create_mode` must be \"Secondary\" when `secondary_type` is \"Named\""),
+		},
+		{
+			Config:      r.hsReadScale(data),
+			ExpectError: regexp.MustCompile("`read_scale` is not supported for Hyperscale databases"),
+		},
 	})
 }
 
@@ -2144,7 +2168,33 @@ resource "azurerm_mssql_database" "secondary" {
 `, r.hs(data), data.RandomInteger)
 }
 
-func (r MsSqlDatabaseResource) namedReplicationZoneRedundant(data acceptance.TestData) string {
+func (r MsSqlDatabaseResource) namedReplicationWithoutSecondaryCreateMode(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "secondary" {
+  name                        = "acctest-dbs2-%[2]d"
+  server_id                   = azurerm_mssql_server.test.id
+  secondary_type              = "Named"
+  creation_source_database_id = azurerm_mssql_database.test.id
+}
+`, r.hs(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseResource) hsReadScale(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name       = "acctest-db-%[2]d"
+  server_id  = azurerm_mssql_server.test.id
+  sku_name   = "HS_Gen5_2"
+  read_scale = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseResource) namedReplicationZoneRedundant(data acceptance.TestData, replicaCount int) string {
 	return fmt.Sprintf(`
 %[1]s
 
@@ -2166,7 +2216,7 @@ resource "azurerm_mssql_database" "secondary" {
   creation_source_database_id = azurerm_mssql_database.test.id
 
   zone_redundant     = true
-  read_replica_count = 1
+  read_replica_count = %[3]d
 }
-`, r.template(data), data.RandomInteger)
+`, r.template(data), data.RandomInteger, replicaCount)
 }
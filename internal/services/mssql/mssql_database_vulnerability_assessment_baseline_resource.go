@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/databasesqlvulnerabilityassessmentrulebaselines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DatabaseVulnerabilityAssessmentBaselineModel struct {
+	DatabaseId     string                                  `tfschema:"database_id"`
+	RuleId         string                                  `tfschema:"rule_id"`
+	BaselineResult []DatabaseVulnerabilityAssessmentResult `tfschema:"baseline_result"`
+}
+
+type DatabaseVulnerabilityAssessmentResult struct {
+	Result []string `tfschema:"result"`
+}
+
+type DatabaseVulnerabilityAssessmentBaselineResource struct{}
+
+var _ sdk.ResourceWithUpdate = DatabaseVulnerabilityAssessmentBaselineResource{}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSqlDatabaseID,
+		},
+
+		"rule_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"baseline_result": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"result": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) ModelObject() interface{} {
+	return &DatabaseVulnerabilityAssessmentBaselineModel{}
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) ResourceType() string {
+	return "azurerm_mssql_database_vulnerability_assessment_baseline"
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return databasesqlvulnerabilityassessmentrulebaselines.ValidateBaselineRuleID
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.DatabaseSqlVulnerabilityAssessmentBaselinesClient
+
+			var model DatabaseVulnerabilityAssessmentBaselineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := commonids.ParseSqlDatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := databasesqlvulnerabilityassessmentrulebaselines.NewBaselineRuleID(databaseId.SubscriptionId, databaseId.ResourceGroupName, databaseId.ServerName, databaseId.DatabaseName, model.RuleId)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := databasesqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselineInput{
+				Properties: &databasesqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselineInputProperties{
+					LatestScan: false,
+					Results:    expandDatabaseVulnerabilityAssessmentBaselineResults(model.BaselineResult),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.DatabaseSqlVulnerabilityAssessmentBaselinesClient
+
+			id, err := databasesqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := DatabaseVulnerabilityAssessmentBaselineModel{
+				DatabaseId: commonids.NewSqlDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.DatabaseName).ID(),
+				RuleId:     id.RuleId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.BaselineResult = flattenDatabaseVulnerabilityAssessmentBaselineResults(props.Results)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.DatabaseSqlVulnerabilityAssessmentBaselinesClient
+
+			id, err := databasesqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DatabaseVulnerabilityAssessmentBaselineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := databasesqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselineInput{
+				Properties: &databasesqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselineInputProperties{
+					LatestScan: false,
+					Results:    expandDatabaseVulnerabilityAssessmentBaselineResults(model.BaselineResult),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DatabaseVulnerabilityAssessmentBaselineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.DatabaseSqlVulnerabilityAssessmentBaselinesClient
+
+			id, err := databasesqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDatabaseVulnerabilityAssessmentBaselineResults(input []DatabaseVulnerabilityAssessmentResult) [][]string {
+	output := make([][]string, 0)
+	for _, item := range input {
+		output = append(output, item.Result)
+	}
+	return output
+}
+
+func flattenDatabaseVulnerabilityAssessmentBaselineResults(input [][]string) []DatabaseVulnerabilityAssessmentResult {
+	output := make([]DatabaseVulnerabilityAssessmentResult, 0)
+	for _, item := range input {
+		output = append(output, DatabaseVulnerabilityAssessmentResult{
+			Result: item,
+		})
+	}
+	return output
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/databasesqlvulnerabilityassessmentrulebaselines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlDatabaseVulnerabilityAssessmentBaselineResource struct{}
+
+func TestAccMsSqlDatabaseVulnerabilityAssessmentBaseline_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_vulnerability_assessment_baseline", "test")
+	r := MsSqlDatabaseVulnerabilityAssessmentBaselineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabaseVulnerabilityAssessmentBaseline_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_vulnerability_assessment_baseline", "test")
+	r := MsSqlDatabaseVulnerabilityAssessmentBaselineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("baseline_result.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := databasesqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.DatabaseSqlVulnerabilityAssessmentBaselinesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_vulnerability_assessment_baseline" "test" {
+  database_id = azurerm_mssql_database.test.id
+  rule_id     = "VA2111"
+
+  baseline_result {
+    result = [
+      "SCHEMA",
+      "dbo",
+      "CONTROL",
+      "SQL_USER",
+      "adminuser1"
+    ]
+  }
+
+  depends_on = [azurerm_mssql_server_express_vulnerability_assessment.test]
+}
+`, r.template(data))
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_vulnerability_assessment_baseline" "test" {
+  database_id = azurerm_mssql_database.test.id
+  rule_id     = "VA2111"
+
+  baseline_result {
+    result = [
+      "SCHEMA",
+      "dbo",
+      "CONTROL",
+      "SQL_USER",
+      "adminuser1"
+    ]
+  }
+
+  baseline_result {
+    result = [
+      "SCHEMA",
+      "dbo",
+      "CONTROL",
+      "SQL_USER",
+      "adminuser2"
+    ]
+  }
+
+  depends_on = [azurerm_mssql_server_express_vulnerability_assessment.test]
+}
+`, r.template(data))
+}
+
+func (MsSqlDatabaseVulnerabilityAssessmentBaselineResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_express_vulnerability_assessment" "test" {
+  server_id = azurerm_mssql_server.test.id
+}
+`, MsSqlDatabaseResource{}.basic(data))
+}
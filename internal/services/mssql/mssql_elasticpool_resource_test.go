@@ -328,6 +328,54 @@ func TestAccMsSqlElasticPool_hyperScale(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlElasticPool_hyperScaleMaintenanceConfigurationAndZoneRedundancy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	// the maintenance configuration is region specific
+	data.Locations.Primary = "westeurope"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hyperScale(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_configuration_name").HasValue("SQL_Default"),
+				check.That(data.ResourceName).Key("zone_redundant").HasValue("false"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+		{
+			Config: r.hyperScale(data, `
+  maintenance_configuration_name = "SQL_WestEurope_DB_2"
+  zone_redundant                 = true
+`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_configuration_name").HasValue("SQL_WestEurope_DB_2"),
+				check.That(data.ResourceName).Key("zone_redundant").HasValue("true"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+	})
+}
+
+func TestAccMsSqlElasticPool_hyperScalePerDatabaseSettingsError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.templateHyperScale(data, "HS_Gen5", "Hyperscale", 4, "Gen5", 0.75, 4, ""),
+			ExpectError: regexp.MustCompile(`perDatabaseSettings 'minCapacity' must be 0, 0.25, 0.5 or a whole number of vCores`),
+		},
+		{
+			Config:      r.templateHyperScale(data, "HS_Gen5", "Hyperscale", 4, "Gen5", 0, 0.5, ""),
+			ExpectError: regexp.MustCompile(`perDatabaseSettings 'maxCapacity' must be a whole number of vCores`),
+		},
+	})
+}
+
 func TestAccMsSqlElasticPool_vCoreToStandardDTU(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
 	r := MsSqlElasticPoolResource{}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/sqlvulnerabilityassessmentssettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ServerExpressVulnerabilityAssessmentModel struct {
+	ServerId string `tfschema:"server_id"`
+}
+
+type ServerExpressVulnerabilityAssessmentResource struct{}
+
+var _ sdk.Resource = ServerExpressVulnerabilityAssessmentResource{}
+
+func (r ServerExpressVulnerabilityAssessmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ServerID,
+		},
+	}
+}
+
+func (r ServerExpressVulnerabilityAssessmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServerExpressVulnerabilityAssessmentResource) ModelObject() interface{} {
+	return &ServerExpressVulnerabilityAssessmentModel{}
+}
+
+func (r ServerExpressVulnerabilityAssessmentResource) ResourceType() string {
+	return "azurerm_mssql_server_express_vulnerability_assessment"
+}
+
+func (r ServerExpressVulnerabilityAssessmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ServerSqlVulnerabilityAssessmentID
+}
+
+func (r ServerExpressVulnerabilityAssessmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ServerSqlVulnerabilityAssessmentsClient
+
+			var model ServerExpressVulnerabilityAssessmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := parse.ServerID(model.ServerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewServerSqlVulnerabilityAssessmentID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, "default")
+			sdkId := commonids.NewSqlServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName)
+
+			// the settings always exist on the server, so we treat the express configuration as existing when it's enabled
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if expressVulnerabilityAssessmentEnabled(existing.Model) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessment{
+				Properties: &sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentPolicyProperties{
+					State: pointer.To(sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateEnabled),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, sdkId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServerExpressVulnerabilityAssessmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ServerSqlVulnerabilityAssessmentsClient
+
+			id, err := parse.ServerSqlVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			sdkId := commonids.NewSqlServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName)
+
+			resp, err := client.Get(ctx, sdkId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if !expressVulnerabilityAssessmentEnabled(resp.Model) {
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&ServerExpressVulnerabilityAssessmentModel{
+				ServerId: parse.NewServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName).ID(),
+			})
+		},
+	}
+}
+
+func (r ServerExpressVulnerabilityAssessmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ServerSqlVulnerabilityAssessmentsClient
+
+			id, err := parse.ServerSqlVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			sdkId := commonids.NewSqlServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName)
+
+			payload := sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessment{
+				Properties: &sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentPolicyProperties{
+					State: pointer.To(sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateDisabled),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, sdkId, payload); err != nil {
+				return fmt.Errorf("disabling %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expressVulnerabilityAssessmentEnabled(input *sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessment) bool {
+	if input == nil || input.Properties == nil {
+		return false
+	}
+
+	return pointer.From(input.Properties.State) == sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateEnabled
+}
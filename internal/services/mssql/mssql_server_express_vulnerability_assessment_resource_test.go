@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/sqlvulnerabilityassessmentssettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlServerExpressVulnerabilityAssessmentResource struct{}
+
+func TestAccMsSqlServerExpressVulnerabilityAssessment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_express_vulnerability_assessment", "test")
+	r := MsSqlServerExpressVulnerabilityAssessmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlServerExpressVulnerabilityAssessment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_express_vulnerability_assessment", "test")
+	r := MsSqlServerExpressVulnerabilityAssessmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (MsSqlServerExpressVulnerabilityAssessmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ServerSqlVulnerabilityAssessmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ServerSqlVulnerabilityAssessmentsClient.Get(ctx, commonids.NewSqlServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	enabled := resp.Model != nil && resp.Model.Properties != nil && pointer.From(resp.Model.Properties.State) == sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateEnabled
+	return pointer.To(enabled), nil
+}
+
+func (r MsSqlServerExpressVulnerabilityAssessmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_express_vulnerability_assessment" "test" {
+  server_id = azurerm_mssql_server.test.id
+}
+`, MsSqlDatabaseResource{}.template(data))
+}
+
+func (r MsSqlServerExpressVulnerabilityAssessmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_express_vulnerability_assessment" "import" {
+  server_id = azurerm_mssql_server_express_vulnerability_assessment.test.server_id
+}
+`, r.basic(data))
+}
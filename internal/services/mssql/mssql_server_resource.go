@@ -329,6 +329,15 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	// the password can't be updated whilst Azure Active Directory Only Authentication is enabled, so when both are
+	// changing together Azure Active Directory Only Authentication needs to be disabled prior to updating the Server
+	oldAADOnly, newAADOnly := d.GetChange("azuread_administrator.0.azuread_authentication_only")
+	if d.HasChange("administrator_login_password") && oldAADOnly.(bool) && !newAADOnly.(bool) {
+		if err := deleteMsSqlServerAADOnlyAuthentication(ctx, aadOnlyAuthenticationsClient, *id); err != nil {
+			return err
+		}
+	}
+
 	if payload := existing.Model; payload != nil {
 		if d.HasChange("tags") {
 			payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
@@ -404,25 +413,8 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 
 		if aadOnlyAdmin {
-			resp, err := aadOnlyAuthenticationsClient.Delete(ctx, *id)
-			if err != nil {
-				log.Printf("[INFO] Deletion of Azure Active Directory Only Authentication failed for %s: %+v", pointer.From(id), err)
-				return fmt.Errorf("deleting Azure Active Directory Only Authentications for %s: %+v", pointer.From(id), err)
-			}
-
-			// NOTE: This call does not return a future it returns a response, but you will get a future back if the status code is 202...
-			// https://learn.microsoft.com/en-us/rest/api/sql/server-azure-ad-only-authentications/delete?view=rest-sql-2023-05-01-preview&tabs=HTTP
-			if response.WasStatusCode(resp.HttpResponse, 202) {
-				// NOTE: It was accepted but not completed, it is now an async operation...
-				// create a custom poller and wait for it to complete as 'Succeeded'...
-				log.Printf("[INFO] Delete Azure Active Directory Only Administrators response was a 202 WaitForStateContext...")
-
-				initialDelayDuration := 5 * time.Second
-				pollerType := custompollers.NewMsSqlServerDeleteServerAzureADOnlyAuthenticationPoller(aadOnlyAuthenticationsClient, pointer.From(id))
-				poller := pollers.NewPoller(pollerType, initialDelayDuration, pollers.DefaultNumberOfDroppedConnectionsToAllow)
-				if err := poller.PollUntilDone(ctx); err != nil {
-					return fmt.Errorf("waiting for the deletion of the Azure Active Directory Only Administrator: %+v", err)
-				}
+			if err := deleteMsSqlServerAADOnlyAuthentication(ctx, aadOnlyAuthenticationsClient, *id); err != nil {
+				return err
 			}
 		}
 
@@ -703,9 +695,35 @@ func msSqlMinimumTLSVersionDiff(ctx context.Context, d *pluginsdk.ResourceDiff,
 }
 
 func msSqlPasswordChangeWhenAADAuthOnly(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) (err error) {
-	old, _ := d.GetChange("azuread_administrator.0.azuread_authentication_only")
-	if old.(bool) && d.HasChange("administrator_login_password") {
+	// the password can be changed in the same apply which disables `azuread_authentication_only`
+	old, new := d.GetChange("azuread_administrator.0.azuread_authentication_only")
+	if old.(bool) && new.(bool) && d.HasChange("administrator_login_password") {
 		err = fmt.Errorf("`administrator_login_password` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
 	}
 	return
 }
+
+func deleteMsSqlServerAADOnlyAuthentication(ctx context.Context, client *serverazureadonlyauthentications.ServerAzureADOnlyAuthenticationsClient, id commonids.SqlServerId) error {
+	resp, err := client.Delete(ctx, id)
+	if err != nil {
+		log.Printf("[INFO] Deletion of Azure Active Directory Only Authentication failed for %s: %+v", id, err)
+		return fmt.Errorf("deleting Azure Active Directory Only Authentications for %s: %+v", id, err)
+	}
+
+	// NOTE: This call does not return a future it returns a response, but you will get a future back if the status code is 202...
+	// https://learn.microsoft.com/en-us/rest/api/sql/server-azure-ad-only-authentications/delete?view=rest-sql-2023-05-01-preview&tabs=HTTP
+	if response.WasStatusCode(resp.HttpResponse, 202) {
+		// NOTE: It was accepted but not completed, it is now an async operation...
+		// create a custom poller and wait for it to complete as 'Succeeded'...
+		log.Printf("[INFO] Delete Azure Active Directory Only Administrators response was a 202 WaitForStateContext...")
+
+		initialDelayDuration := 5 * time.Second
+		pollerType := custompollers.NewMsSqlServerDeleteServerAzureADOnlyAuthenticationPoller(client, id)
+		poller := pollers.NewPoller(pollerType, initialDelayDuration, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			return fmt.Errorf("waiting for the deletion of the Azure Active Directory Only Administrator: %+v", err)
+		}
+	}
+
+	return nil
+}
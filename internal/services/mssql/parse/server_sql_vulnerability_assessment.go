@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ServerSqlVulnerabilityAssessmentId struct {
+	SubscriptionId                 string
+	ResourceGroup                  string
+	ServerName                     string
+	SqlVulnerabilityAssessmentName string
+}
+
+func NewServerSqlVulnerabilityAssessmentID(subscriptionId, resourceGroup, serverName, sqlVulnerabilityAssessmentName string) ServerSqlVulnerabilityAssessmentId {
+	return ServerSqlVulnerabilityAssessmentId{
+		SubscriptionId:                 subscriptionId,
+		ResourceGroup:                  resourceGroup,
+		ServerName:                     serverName,
+		SqlVulnerabilityAssessmentName: sqlVulnerabilityAssessmentName,
+	}
+}
+
+func (id ServerSqlVulnerabilityAssessmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Sql Vulnerability Assessment Name %q", id.SqlVulnerabilityAssessmentName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Server Sql Vulnerability Assessment", segmentsStr)
+}
+
+func (id ServerSqlVulnerabilityAssessmentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/sqlVulnerabilityAssessments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.SqlVulnerabilityAssessmentName)
+}
+
+// ServerSqlVulnerabilityAssessmentID parses a ServerSqlVulnerabilityAssessment ID into an ServerSqlVulnerabilityAssessmentId struct
+func ServerSqlVulnerabilityAssessmentID(input string) (*ServerSqlVulnerabilityAssessmentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ServerSqlVulnerabilityAssessment ID: %+v", input, err)
+	}
+
+	resourceId := ServerSqlVulnerabilityAssessmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.SqlVulnerabilityAssessmentName, err = id.PopSegment("sqlVulnerabilityAssessments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -70,9 +70,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		DatabaseVulnerabilityAssessmentBaselineResource{},
 		MsSqlFailoverGroupResource{},
 		MsSqlVirtualMachineAvailabilityGroupListenerResource{},
 		MsSqlVirtualMachineGroupResource{},
 		ServerDNSAliasResource{},
+		ServerExpressVulnerabilityAssessmentResource{},
 	}
 }
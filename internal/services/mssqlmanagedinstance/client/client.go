@@ -4,7 +4,10 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/startstopmanagedinstanceschedules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -20,11 +23,12 @@ type Client struct {
 	ManagedInstanceEncryptionProtectorClient         *sql.ManagedInstanceEncryptionProtectorsClient
 	ManagedInstanceFailoverGroupsClient              *sql.InstanceFailoverGroupsClient
 	ManagedInstanceKeysClient                        *sql.ManagedInstanceKeysClient
+	ManagedInstanceStartStopSchedulesClient          *startstopmanagedinstanceschedules.StartStopManagedInstanceSchedulesClient
 
 	options *common.ClientOptions
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	managedDatabasesClient := sql.NewManagedDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedDatabasesClient.Client, o.ResourceManagerAuthorizer)
@@ -59,6 +63,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	managedInstanceServerSecurityAlertPoliciesClient := sql.NewManagedServerSecurityAlertPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstanceServerSecurityAlertPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	managedInstanceStartStopSchedulesClient, err := startstopmanagedinstanceschedules.NewStartStopManagedInstanceSchedulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Instance Start Stop Schedules Client: %+v", err)
+	}
+	o.Configure(managedInstanceStartStopSchedulesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ManagedDatabasesClient:                           &managedDatabasesClient,
 		ManagedInstanceAdministratorsClient:              &managedInstancesAdministratorsClient,
@@ -66,6 +76,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ManagedInstanceEncryptionProtectorClient:         &managedInstanceEncryptionProtectorsClient,
 		ManagedInstanceFailoverGroupsClient:              &managedInstanceFailoverGroupsClient,
 		ManagedInstanceKeysClient:                        &managedInstanceKeysClient,
+		ManagedInstanceStartStopSchedulesClient:          managedInstanceStartStopSchedulesClient,
 		ManagedInstancesLongTermRetentionPoliciesClient:  &managedInstancesLongTermRetentionPoliciesClient,
 		ManagedInstanceServerSecurityAlertPoliciesClient: &managedInstanceServerSecurityAlertPoliciesClient,
 		ManagedInstancesShortTermRetentionPoliciesClient: &managedInstancesShortTermRetentionPoliciesClient,
@@ -73,7 +84,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ManagedInstancesClient:                           &managedInstancesClient,
 
 		options: o,
-	}
+	}, nil
 }
 
 func (c Client) ManagedInstancesClientForSubscription(subscriptionID string) *sql.ManagedInstancesClient {
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/startstopmanagedinstanceschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the API only supports a single schedule per Managed Instance, which is always named `default`
+const managedInstanceStartStopScheduleName = "default"
+
+type MsSqlManagedInstanceStartStopScheduleModel struct {
+	ManagedInstanceId string                                  `tfschema:"managed_instance_id"`
+	Description       string                                  `tfschema:"description"`
+	TimezoneId        string                                  `tfschema:"timezone_id"`
+	Schedule          []MsSqlManagedInstanceStartStopSchedule `tfschema:"schedule"`
+	NextExecutionTime string                                  `tfschema:"next_execution_time"`
+	NextRunAction     string                                  `tfschema:"next_run_action"`
+}
+
+type MsSqlManagedInstanceStartStopSchedule struct {
+	StartDay  string `tfschema:"start_day"`
+	StartTime string `tfschema:"start_time"`
+	StopDay   string `tfschema:"stop_day"`
+	StopTime  string `tfschema:"stop_time"`
+}
+
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceStartStopScheduleResource{}
+
+type MsSqlManagedInstanceStartStopScheduleResource struct{}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_start_stop_schedule"
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceStartStopScheduleModel{}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceStartStopScheduleID
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	timeValidation := validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "the time must be in the format `HH:mm`")
+
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_day": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(startstopmanagedinstanceschedules.PossibleValuesForDayOfWeek(), false),
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: timeValidation,
+					},
+
+					"stop_day": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(startstopmanagedinstanceschedules.PossibleValuesForDayOfWeek(), false),
+					},
+
+					"stop_time": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: timeValidation,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"timezone_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "UTC",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"next_execution_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_run_action": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceStartStopSchedulesClient
+
+			var model MsSqlManagedInstanceStartStopScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return fmt.Errorf("parsing `managed_instance_id`: %v", err)
+			}
+
+			id := parse.NewManagedInstanceStartStopScheduleID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, managedInstanceStartStopScheduleName)
+			sdkId := commonids.NewSqlManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := startstopmanagedinstanceschedules.StartStopManagedInstanceSchedule{
+				Properties: &startstopmanagedinstanceschedules.StartStopManagedInstanceScheduleProperties{
+					Description:  pointer.To(model.Description),
+					ScheduleList: expandMsSqlManagedInstanceStartStopSchedule(model.Schedule),
+					TimeZoneId:   pointer.To(model.TimezoneId),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, sdkId, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			sdkId := commonids.NewSqlManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+
+			var model MsSqlManagedInstanceStartStopScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("schedule") {
+				payload.Properties.ScheduleList = expandMsSqlManagedInstanceStartStopSchedule(model.Schedule)
+			}
+
+			if metadata.ResourceData.HasChange("timezone_id") {
+				payload.Properties.TimeZoneId = pointer.To(model.TimezoneId)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, sdkId, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			sdkId := commonids.NewSqlManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+
+			resp, err := client.Get(ctx, sdkId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := MsSqlManagedInstanceStartStopScheduleModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Description = pointer.From(props.Description)
+					state.TimezoneId = pointer.From(props.TimeZoneId)
+					state.Schedule = flattenMsSqlManagedInstanceStartStopSchedule(props.ScheduleList)
+					state.NextExecutionTime = pointer.From(props.NextExecutionTime)
+					state.NextRunAction = pointer.From(props.NextRunAction)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			sdkId := commonids.NewSqlManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+
+			if _, err := client.Delete(ctx, sdkId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMsSqlManagedInstanceStartStopSchedule(input []MsSqlManagedInstanceStartStopSchedule) []startstopmanagedinstanceschedules.ScheduleItem {
+	output := make([]startstopmanagedinstanceschedules.ScheduleItem, 0)
+	for _, item := range input {
+		output = append(output, startstopmanagedinstanceschedules.ScheduleItem{
+			StartDay:  startstopmanagedinstanceschedules.DayOfWeek(item.StartDay),
+			StartTime: item.StartTime,
+			StopDay:   startstopmanagedinstanceschedules.DayOfWeek(item.StopDay),
+			StopTime:  item.StopTime,
+		})
+	}
+	return output
+}
+
+func flattenMsSqlManagedInstanceStartStopSchedule(input []startstopmanagedinstanceschedules.ScheduleItem) []MsSqlManagedInstanceStartStopSchedule {
+	output := make([]MsSqlManagedInstanceStartStopSchedule, 0)
+	for _, item := range input {
+		output = append(output, MsSqlManagedInstanceStartStopSchedule{
+			StartDay:  string(item.StartDay),
+			StartTime: item.StartTime,
+			StopDay:   string(item.StopDay),
+			StopTime:  item.StopTime,
+		})
+	}
+	return output
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlManagedInstanceStartStopScheduleResource struct{}
+
+func TestAccMsSqlManagedInstanceStartStopSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_start_stop_schedule", "test")
+	r := MsSqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedInstanceStartStopSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_start_stop_schedule", "test")
+	r := MsSqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlManagedInstanceStartStopSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_start_stop_schedule", "test")
+	r := MsSqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceStartStopScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQLManagedInstance.ManagedInstanceStartStopSchedulesClient.Get(ctx, commonids.NewSqlManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_start_stop_schedule" "test" {
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "08:00"
+    stop_day   = "Friday"
+    stop_time  = "18:00"
+  }
+}
+`, MsSqlManagedInstanceResource{}.basic(data))
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_start_stop_schedule" "import" {
+  managed_instance_id = azurerm_mssql_managed_instance_start_stop_schedule.test.managed_instance_id
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "08:00"
+    stop_day   = "Friday"
+    stop_time  = "18:00"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_start_stop_schedule" "test" {
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+  description         = "Stop outside of office hours"
+  timezone_id         = "Central European Standard Time"
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "07:30"
+    stop_day   = "Wednesday"
+    stop_time  = "19:00"
+  }
+
+  schedule {
+    start_day  = "Thursday"
+    start_time = "07:30"
+    stop_day   = "Friday"
+    stop_time  = "17:00"
+  }
+}
+`, MsSqlManagedInstanceResource{}.basic(data))
+}
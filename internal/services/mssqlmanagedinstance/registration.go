@@ -59,5 +59,6 @@ func (r Registration) Resources() []sdk.Resource {
 		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
 		MsSqlManagedInstanceFailoverGroupResource{},
 		MsSqlManagedInstanceResource{},
+		MsSqlManagedInstanceStartStopScheduleResource{},
 	}
 }
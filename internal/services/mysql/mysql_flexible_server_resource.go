@@ -272,6 +272,12 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"accelerated_logs_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -311,6 +317,18 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("storage.0.size_gb", func(ctx context.Context, old, new, meta interface{}) bool {
 				return new.(int) < old.(int)
 			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if !diff.Get("storage.0.accelerated_logs_enabled").(bool) || !diff.NewValueKnown("sku_name") {
+					return nil
+				}
+
+				// accelerated logs are only available on the Business Critical (`MO_`) tier
+				if skuName := diff.Get("sku_name").(string); skuName != "" && !strings.HasPrefix(skuName, "MO_") {
+					return fmt.Errorf("`storage.0.accelerated_logs_enabled` can only be enabled when `sku_name` uses the Business Critical (`MO_`) tier")
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -366,11 +384,8 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	storageSettings := expandArmServerStorage(d.Get("storage").([]interface{}))
-	if storageSettings != nil {
-		if storageSettings.Iops != nil && *storageSettings.AutoIoScaling == servers.EnableStatusEnumEnabled {
-			return fmt.Errorf("`iops` can not be set if `io_scaling_enabled` is set to true")
-		}
+	if d.Get("storage.0.io_scaling_enabled").(bool) && d.Get("storage.0.iops").(int) != 0 {
+		return fmt.Errorf("`iops` can not be set if `io_scaling_enabled` is set to true")
 	}
 
 	sku, err := expandFlexibleServerSku(d.Get("sku_name").(string))
@@ -619,12 +634,18 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	storage := expandArmServerStorage(d.Get("storage").([]interface{}))
+	if storage != nil && storage.LogOnDisk == nil && d.HasChange("storage.0.accelerated_logs_enabled") {
+		// `LogOnDisk` is only sent when accelerated logs are enabled, so disabling them has to be explicit
+		storage.LogOnDisk = pointer.To(servers.EnableStatusEnumDisabled)
+	}
+
 	// ha Enabled is dependent on storage auto grow Enabled. But when we enabled this two features in one request, it returns bad request.
 	// Thus we need to separate these two updates in two requests.
 	if d.HasChange("storage") && d.Get("storage.0.auto_grow_enabled").(bool) {
 		parameters := servers.ServerForUpdate{
 			Properties: &servers.ServerPropertiesForUpdate{
-				Storage: expandArmServerStorage(d.Get("storage").([]interface{})),
+				Storage: storage,
 			},
 		}
 
@@ -710,7 +731,7 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 	if d.HasChange("storage") && !d.Get("storage.0.auto_grow_enabled").(bool) {
 		parameters := servers.ServerForUpdate{
 			Properties: &servers.ServerPropertiesForUpdate{
-				Storage: expandArmServerStorage(d.Get("storage").([]interface{})),
+				Storage: storage,
 			},
 		}
 
@@ -796,10 +817,15 @@ func expandArmServerStorage(inputs []interface{}) *servers.Storage {
 		storage.StorageSizeGB = utils.Int64(int64(v))
 	}
 
-	if v := input["iops"].(int); v != 0 {
+	// `iops` is managed by the service when `io_scaling_enabled` is set, so the computed value mustn't be sent back
+	if v := input["iops"].(int); v != 0 && autoIoScaling == servers.EnableStatusEnumDisabled {
 		storage.Iops = utils.Int64(int64(v))
 	}
 
+	if v := input["accelerated_logs_enabled"].(bool); v {
+		storage.LogOnDisk = pointer.To(servers.EnableStatusEnumEnabled)
+	}
+
 	return &storage
 }
 
@@ -819,10 +845,11 @@ func flattenArmServerStorage(storage *servers.Storage) []interface{} {
 
 	return []interface{}{
 		map[string]interface{}{
-			"size_gb":            size,
-			"iops":               iops,
-			"auto_grow_enabled":  *storage.AutoGrow == servers.EnableStatusEnumEnabled,
-			"io_scaling_enabled": *storage.AutoIoScaling == servers.EnableStatusEnumEnabled,
+			"size_gb":                  size,
+			"iops":                     iops,
+			"auto_grow_enabled":        pointer.From(storage.AutoGrow) == servers.EnableStatusEnumEnabled,
+			"io_scaling_enabled":       pointer.From(storage.AutoIoScaling) == servers.EnableStatusEnumEnabled,
+			"accelerated_logs_enabled": pointer.From(storage.LogOnDisk) == servers.EnableStatusEnumEnabled,
 		},
 	}
 }
@@ -384,6 +384,37 @@ func TestAccMySqlFlexibleServer_updateStorage(t *testing.T) {
 	})
 }
 
+func TestAccMySqlFlexibleServer_acceleratedLogs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
+	r := MySqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.acceleratedLogs(data, false, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password"),
+		{
+			Config: r.acceleratedLogs(data, true, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage.0.accelerated_logs_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("administrator_password"),
+		{
+			Config: r.acceleratedLogs(data, false, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage.0.accelerated_logs_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("administrator_password"),
+	})
+}
+
 func TestAccMySqlFlexibleServer_updateReplicationRole(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
 	r := MySqlFlexibleServerResource{}
@@ -1031,6 +1062,29 @@ resource "azurerm_mysql_flexible_server" "test" {
 `, r.template(data), data.RandomInteger, sizeGB, autoGrowEnabled, ioScalingEnabled)
 }
 
+func (r MySqlFlexibleServerResource) acceleratedLogs(data acceptance.TestData, acceleratedLogsEnabled bool, ioScalingEnabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  sku_name               = "MO_Standard_E2ds_v4"
+  version                = "8.0.21"
+  zone                   = "1"
+
+  storage {
+    size_gb                  = 64
+    accelerated_logs_enabled = %t
+    io_scaling_enabled       = %t
+  }
+}
+`, r.template(data), data.RandomInteger, acceleratedLogsEnabled, ioScalingEnabled)
+}
+
 func (r MySqlFlexibleServerResource) failover(data acceptance.TestData, primaryZone string, standbyZone string) string {
 	return fmt.Sprintf(`
 %s
@@ -85,6 +85,11 @@ func dataSourceNetAppVolume() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"large_volume_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"protocols": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -206,6 +211,7 @@ func dataSourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("security_style", string(pointer.From(props.SecurityStyle)))
 
 		d.Set("storage_quota_in_gb", props.UsageThreshold/1073741824)
+		d.Set("large_volume_enabled", pointer.From(props.IsLargeVolume))
 		if err := d.Set("mount_ip_addresses", flattenNetAppVolumeMountIPAddresses(props.MountTargets)); err != nil {
 			return fmt.Errorf("setting `mount_ip_addresses`: %+v", err)
 		}
@@ -227,6 +227,16 @@ func (r NetAppVolumeGroupSapHanaDataSource) Attributes() map[string]*pluginsdk.S
 							},
 						},
 					},
+
+					"encryption_key_source": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"key_vault_private_endpoint_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
 				},
 			},
 		},
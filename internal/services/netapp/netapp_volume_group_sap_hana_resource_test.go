@@ -28,6 +28,7 @@ func TestAccNetAppVolumeGroupSapHana_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("volume.0.encryption_key_source").HasValue("Microsoft.NetApp"),
 			),
 		},
 		data.ImportStep(),
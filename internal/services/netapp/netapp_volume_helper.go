@@ -147,6 +147,14 @@ func expandNetAppVolumeGroupVolumes(input []netAppModels.NetAppVolumeGroupVolume
 			Tags: &item.Tags,
 		}
 
+		if item.EncryptionKeySource != "" {
+			volumeProperties.Properties.EncryptionKeySource = pointer.To(volumegroups.EncryptionKeySource(item.EncryptionKeySource))
+		}
+
+		if item.KeyVaultPrivateEndpointId != "" {
+			volumeProperties.Properties.KeyVaultPrivateEndpointResourceId = pointer.To(item.KeyVaultPrivateEndpointId)
+		}
+
 		results = append(results, *volumeProperties)
 	}
 
@@ -296,6 +304,8 @@ func flattenNetAppVolumeGroupVolumes(ctx context.Context, input *[]volumegroups.
 		volumeGroupVolume.Tags = pointer.From(item.Tags)
 		volumeGroupVolume.ProximityPlacementGroupId = utils.NormalizeNilableString(props.ProximityPlacementGroup)
 		volumeGroupVolume.VolumeSpecName = pointer.From(props.VolumeSpecName)
+		volumeGroupVolume.EncryptionKeySource = string(pointer.From(props.EncryptionKeySource))
+		volumeGroupVolume.KeyVaultPrivateEndpointId = pointer.From(props.KeyVaultPrivateEndpointResourceId)
 
 		if props.UsageThreshold > 0 {
 			usageThreshold := props.UsageThreshold / 1073741824
@@ -4,6 +4,7 @@
 package netapp
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/capacitypools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumesreplication"
@@ -48,6 +50,17 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the limits of `storage_quota_in_gb` depend on whether this is a large volume
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if !d.NewValueKnown("storage_quota_in_gb") || !d.NewValueKnown("large_volume_enabled") {
+					return nil
+				}
+
+				return ValidateNetAppVolumeStorageQuota(int64(d.Get("storage_quota_in_gb").(int)), d.Get("large_volume_enabled").(bool))
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"resource_group_name": commonschema.ResourceGroupName(),
 
@@ -160,7 +173,14 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 			"storage_quota_in_gb": {
 				Type:         pluginsdk.TypeInt,
 				Required:     true,
-				ValidateFunc: validation.IntBetween(100, 102400),
+				ValidateFunc: validation.IntBetween(MinVolumeQuotaInGB, MaxLargeVolumeQuotaInGB),
+			},
+
+			"large_volume_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
 			},
 
 			"throughput_in_mibps": {
@@ -431,6 +451,20 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("ntfs security style cannot be used in a NFSv3/NFSv4.1 enabled volume for %s", id)
 	}
 
+	largeVolumeEnabled := d.Get("large_volume_enabled").(bool)
+
+	// Large volumes must fit into the capacity pool, the API otherwise fails with an unhelpful error after a long wait
+	if largeVolumeEnabled {
+		poolId := capacitypools.NewCapacityPoolID(id.SubscriptionId, id.ResourceGroupName, id.NetAppAccountName, id.CapacityPoolName)
+		pool, err := meta.(*clients.Client).NetApp.PoolClient.PoolsGet(ctx, poolId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", poolId, err)
+		}
+		if model := pool.Model; model != nil && int64(d.Get("storage_quota_in_gb").(int)) > model.Properties.Size/1073741824 {
+			return fmt.Errorf("`storage_quota_in_gb` (%d) cannot exceed the size of %s (%d GiB)", d.Get("storage_quota_in_gb").(int), poolId, model.Properties.Size/1073741824)
+		}
+	}
+
 	storageQuotaInGB := int64(d.Get("storage_quota_in_gb").(int) * 1073741824)
 
 	exportPolicyRuleRaw := d.Get("export_policy_rule").([]interface{})
@@ -548,6 +582,7 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 			},
 			AvsDataStore:             &avsDataStoreEnabled,
 			SnapshotDirectoryVisible: utils.Bool(snapshotDirectoryVisible),
+			IsLargeVolume:            pointer.To(largeVolumeEnabled),
 		},
 		Tags:  tags.Expand(d.Get("tags").(map[string]interface{})),
 		Zones: zones,
@@ -747,6 +782,7 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("snapshot_directory_visible", props.SnapshotDirectoryVisible)
 		d.Set("throughput_in_mibps", props.ThroughputMibps)
 		d.Set("storage_quota_in_gb", props.UsageThreshold/1073741824)
+		d.Set("large_volume_enabled", pointer.From(props.IsLargeVolume))
 		d.Set("encryption_key_source", string(pointer.From(props.EncryptionKeySource)))
 		d.Set("key_vault_private_endpoint_id", props.KeyVaultPrivateEndpointResourceId)
 
@@ -156,6 +156,13 @@ func ValidateNetAppVolumeGroupSAPHanaVolumes(volumeList *[]volumegroups.VolumeGr
 			errors = append(errors, fmt.Errorf("'%v volume spec type must have PPG defined for %v on volume %v'", pointer.From(volume.Properties.VolumeSpecName), applicationType, pointer.From(volume.Name)))
 		}
 
+		// Validating that customer-managed key encryption has a Key Vault private endpoint defined
+		if strings.EqualFold(string(pointer.From(volume.Properties.EncryptionKeySource)), string(volumegroups.EncryptionKeySourceMicrosoftPointKeyVault)) &&
+			utils.NormalizeNilableString(volume.Properties.KeyVaultPrivateEndpointResourceId) == "" {
+
+			errors = append(errors, fmt.Errorf("'key vault private endpoint must be defined when encryption key source is %v for %v on volume %v'", string(volumegroups.EncryptionKeySourceMicrosoftPointKeyVault), applicationType, pointer.From(volume.Name)))
+		}
+
 		// Adding volume spec name to hashmap for post volume loop check
 		volumeSpecRepeatCount[pointer.From(volume.Properties.VolumeSpecName)] += 1
 	}
@@ -534,6 +534,33 @@ func TestValidateNetAppVolumeGroupSAPHanaVolumes(t *testing.T) {
 			},
 			Errors: 1,
 		},
+		{
+			Name: "ValidateKeyVaultPrivateEndpointRequiredForCustomerManagedKey",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // data
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameSapHanaData))),
+					Properties: volumegroups.VolumeProperties{
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameSapHanaData)),
+						EncryptionKeySource:     pointer.To(volumegroups.EncryptionKeySourceMicrosoftPointKeyVault),
+					},
+				},
+				{ // log
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameSapHanaLog))),
+					Properties: volumegroups.VolumeProperties{
+						ProtocolTypes:                     pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup:           pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:                     pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:                    pointer.To(string(VolumeSpecNameSapHanaLog)),
+						EncryptionKeySource:               pointer.To(volumegroups.EncryptionKeySourceMicrosoftPointKeyVault),
+						KeyVaultPrivateEndpointResourceId: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateEndpoints/pe1"),
+					},
+				},
+			},
+			Errors: 1,
+		},
 	}
 
 	for _, tc := range cases {
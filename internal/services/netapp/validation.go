@@ -4,10 +4,34 @@
 package netapp
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
 
+const (
+	MinVolumeQuotaInGB      = 100
+	MaxVolumeQuotaInGB      = 102400
+	MinLargeVolumeQuotaInGB = 51200
+	MaxLargeVolumeQuotaInGB = 1048576
+)
+
+// ValidateNetAppVolumeStorageQuota validates the storage quota of a volume against the limits
+// for regular volumes and large volumes
+func ValidateNetAppVolumeStorageQuota(quotaInGB int64, largeVolume bool) error {
+	if largeVolume {
+		if quotaInGB < MinLargeVolumeQuotaInGB || quotaInGB > MaxLargeVolumeQuotaInGB {
+			return fmt.Errorf("`storage_quota_in_gb` must be between %d and %d when `large_volume_enabled` is `true`, got %d", MinLargeVolumeQuotaInGB, MaxLargeVolumeQuotaInGB, quotaInGB)
+		}
+		return nil
+	}
+
+	if quotaInGB < MinVolumeQuotaInGB || quotaInGB > MaxVolumeQuotaInGB {
+		return fmt.Errorf("`storage_quota_in_gb` must be between %d and %d, `large_volume_enabled` must be set to `true` for larger volumes, got %d", MinVolumeQuotaInGB, MaxVolumeQuotaInGB, quotaInGB)
+	}
+	return nil
+}
+
 // TODO: this should likely be moved to utils, or removed in case of using the values from the source snapshot?
 
 func ValidateSlicesEquality(source, new []string, caseSensitive bool) bool {
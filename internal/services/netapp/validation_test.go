@@ -96,3 +96,60 @@ func TestValidateSlicesEquality(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateNetAppVolumeStorageQuota(t *testing.T) {
+	testData := []struct {
+		quotaInGB   int64
+		largeVolume bool
+		expectError bool
+	}{
+		{
+			// Regular volume within limits
+			quotaInGB:   100,
+			largeVolume: false,
+			expectError: false,
+		},
+		{
+			// Regular volume at maximum
+			quotaInGB:   102400,
+			largeVolume: false,
+			expectError: false,
+		},
+		{
+			// Regular volume over maximum
+			quotaInGB:   102401,
+			largeVolume: false,
+			expectError: true,
+		},
+		{
+			// Large volume under minimum
+			quotaInGB:   51199,
+			largeVolume: true,
+			expectError: true,
+		},
+		{
+			// Large volume within limits
+			quotaInGB:   204800,
+			largeVolume: true,
+			expectError: false,
+		},
+		{
+			// Large volume over maximum
+			quotaInGB:   1048577,
+			largeVolume: true,
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d GiB where 'largeVolume' = %v..", v.quotaInGB, v.largeVolume)
+
+		err := ValidateNetAppVolumeStorageQuota(v.quotaInGB, v.largeVolume)
+		if v.expectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
@@ -19,8 +19,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-07-01/applicationgateways"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/applicationgateways"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -811,6 +811,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
+								string(applicationgateways.ApplicationGatewaySkuNameBasic),
 								string(applicationgateways.ApplicationGatewaySkuNameStandardSmall),
 								string(applicationgateways.ApplicationGatewaySkuNameStandardMedium),
 								string(applicationgateways.ApplicationGatewaySkuNameStandardLarge),
@@ -825,6 +826,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
+								string(applicationgateways.ApplicationGatewayTierBasic),
 								string(applicationgateways.ApplicationGatewayTierStandard),
 								string(applicationgateways.ApplicationGatewayTierStandardVTwo),
 								string(applicationgateways.ApplicationGatewayTierWAF),
@@ -4740,6 +4742,35 @@ func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceD
 		}
 	}
 
+	skuName := d.Get("sku.0.name").(string)
+	isBasicTier := strings.EqualFold(tier, string(applicationgateways.ApplicationGatewayTierBasic))
+	if isBasicTier != strings.EqualFold(skuName, string(applicationgateways.ApplicationGatewaySkuNameBasic)) {
+		return fmt.Errorf("the `Basic` SKU `name` must be used together with the `Basic` SKU `tier`, got name %q and tier %q", skuName, tier)
+	}
+
+	if isBasicTier {
+		if _, ok := d.GetOk("waf_configuration"); ok {
+			return fmt.Errorf("`waf_configuration` is not supported for the `Basic` SKU tier")
+		}
+		if _, ok := d.GetOk("firewall_policy_id"); ok {
+			return fmt.Errorf("`firewall_policy_id` is not supported for the `Basic` SKU tier")
+		}
+	}
+
+	// the API supports upgrading a `Basic` gateway to a v2 tier in-place, however moving from a v2 tier down to `Basic` requires recreation
+	if d.HasChange("sku.0.tier") && d.Id() != "" {
+		oldTier, _ := d.GetChange("sku.0.tier")
+		if isBasicTier && !strings.EqualFold(oldTier.(string), string(applicationgateways.ApplicationGatewayTierBasic)) {
+			if err := d.ForceNew("sku.0.tier"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := validateApplicationGatewayPrivateOnlyFrontend(d, tier); err != nil {
+		return err
+	}
+
 	if hasCapacity {
 		if (strings.EqualFold(tier, string(applicationgateways.ApplicationGatewayTierStandard)) || strings.EqualFold(tier, string(applicationgateways.ApplicationGatewayTierWAF))) && (capacity.(int) < 1 || capacity.(int) > 32) {
 			return fmt.Errorf("The value '%d' exceeds the maximum capacity allowed for a %q V1 SKU, the %q SKU must have a capacity value between 1 and 32", capacity, tier, tier)
@@ -4753,6 +4784,40 @@ func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceD
 	return nil
 }
 
+// validateApplicationGatewayPrivateOnlyFrontend ensures that a private-only v2/Basic gateway (one without any public
+// frontend) uses a static private IP address, as required by the API
+func validateApplicationGatewayPrivateOnlyFrontend(d *pluginsdk.ResourceDiff, tier string) error {
+	if strings.EqualFold(tier, string(applicationgateways.ApplicationGatewayTierStandard)) || strings.EqualFold(tier, string(applicationgateways.ApplicationGatewayTierWAF)) {
+		return nil
+	}
+
+	frontends := d.Get("frontend_ip_configuration").([]interface{})
+	for i, raw := range frontends {
+		if raw == nil {
+			continue
+		}
+		// the public IP may not be known until apply, in which case we can't tell whether this is private-only
+		if !d.NewValueKnown(fmt.Sprintf("frontend_ip_configuration.%d.public_ip_address_id", i)) {
+			return nil
+		}
+		if v := raw.(map[string]interface{}); v["public_ip_address_id"].(string) != "" {
+			return nil
+		}
+	}
+
+	for _, raw := range frontends {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+		if !strings.EqualFold(v["private_ip_address_allocation"].(string), string(applicationgateways.IPAllocationMethodStatic)) {
+			return fmt.Errorf("a private-only Application Gateway using the %q SKU tier requires the `frontend_ip_configuration` %q to use a `Static` `private_ip_address_allocation`", tier, v["name"].(string))
+		}
+	}
+
+	return nil
+}
+
 func applicationGatewayHttpListnerHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -19,12 +19,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	mariadbServers "github.com/hashicorp/go-azure-sdk/resource-manager/mariadb/2018-06-01/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2017-12-01/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/applicationsecuritygroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privatednszonegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/privateendpoints"
 	postgresqlServers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2023-08-01/redis"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2023-02-01/signalr"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -215,6 +216,17 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			"application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				// NOTE: O+C as Application Security Groups can also be associated using the `azurerm_private_endpoint_application_security_group_association` resource
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: applicationsecuritygroups.ValidateApplicationSecurityGroupID,
+				},
+			},
+
 			"custom_dns_configs": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -332,6 +344,10 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("application_security_group_ids"); ok {
+		parameters.Properties.ApplicationSecurityGroups = expandPrivateEndpointApplicationSecurityGroups(v.(*pluginsdk.Set).List())
+	}
+
 	err = validatePrivateLinkServiceId(*parameters.Properties.PrivateLinkServiceConnections)
 	if err != nil {
 		return err
@@ -475,6 +491,9 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	applicationSecurityGroupAssociation := existing.Model.Properties.ApplicationSecurityGroups
+	if d.HasChange("application_security_group_ids") {
+		applicationSecurityGroupAssociation = expandPrivateEndpointApplicationSecurityGroups(d.Get("application_security_group_ids").(*pluginsdk.Set).List())
+	}
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	privateDnsZoneGroup := d.Get("private_dns_zone_group").([]interface{})
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
@@ -648,6 +667,10 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("setting `ip_configuration`: %+v", err)
 			}
 
+			if err := d.Set("application_security_group_ids", flattenPrivateEndpointApplicationSecurityGroups(props.ApplicationSecurityGroups)); err != nil {
+				return fmt.Errorf("setting `application_security_group_ids`: %+v", err)
+			}
+
 			subnetId := ""
 			if props.Subnet != nil && props.Subnet.Id != nil {
 				subnetId = *props.Subnet.Id
@@ -825,6 +848,38 @@ func flattenPrivateEndpointIPConfigurations(ipConfigurations *[]privateendpoints
 	return results
 }
 
+func expandPrivateEndpointApplicationSecurityGroups(input []interface{}) *[]privateendpoints.ApplicationSecurityGroup {
+	output := make([]privateendpoints.ApplicationSecurityGroup, 0)
+	for _, v := range input {
+		output = append(output, privateendpoints.ApplicationSecurityGroup{
+			Id: pointer.To(v.(string)),
+		})
+	}
+
+	return &output
+}
+
+func flattenPrivateEndpointApplicationSecurityGroups(input *[]privateendpoints.ApplicationSecurityGroup) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+
+		id := *v.Id
+		if parsed, err := applicationsecuritygroups.ParseApplicationSecurityGroupIDInsensitively(id); err == nil {
+			id = parsed.ID()
+		}
+		output = append(output, id)
+	}
+
+	return output
+}
+
 func flattenCustomDnsConfigs(customDnsConfigs *[]privateendpoints.CustomDnsConfigPropertiesFormat) []interface{} {
 	results := make([]interface{}, 0)
 	if customDnsConfigs == nil {
@@ -1124,6 +1179,61 @@ func validatePrivateEndpointSettings(d *pluginsdk.ResourceData) error {
 		}
 	}
 
+	return validatePrivateEndpointIPConfigurations(privateServiceConnections, d.Get("ip_configuration").([]interface{}))
+}
+
+// validatePrivateEndpointIPConfigurations ensures each static IP configuration targets one of the sub resources of the
+// private service connection, and that each member of a sub resource is only assigned a single IP address
+func validatePrivateEndpointIPConfigurations(privateServiceConnections []interface{}, ipConfigurations []interface{}) error {
+	subresourceNames := make(map[string]struct{})
+	for _, psc := range privateServiceConnections {
+		privateServiceConnection := psc.(map[string]interface{})
+		for _, name := range privateServiceConnection["subresource_names"].([]interface{}) {
+			subresourceNames[strings.ToLower(name.(string))] = struct{}{}
+		}
+	}
+
+	members := make(map[string]string)
+	configurationsPerSubresource := make(map[string]int)
+	for _, raw := range ipConfigurations {
+		if raw == nil {
+			continue
+		}
+		ipConfiguration := raw.(map[string]interface{})
+		name := ipConfiguration["name"].(string)
+		subresourceName := ipConfiguration["subresource_name"].(string)
+		memberName := ipConfiguration["member_name"].(string)
+
+		if subresourceName != "" && len(subresourceNames) > 0 {
+			if _, ok := subresourceNames[strings.ToLower(subresourceName)]; !ok {
+				return fmt.Errorf(`"ip_configuration":%q is invalid, the "subresource_name" %q must be one of the "subresource_names" of the "private_service_connection"`, name, subresourceName)
+			}
+		}
+
+		configurationsPerSubresource[strings.ToLower(subresourceName)]++
+
+		if memberName == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", strings.ToLower(subresourceName), strings.ToLower(memberName))
+		if existing, ok := members[key]; ok {
+			return fmt.Errorf(`"ip_configuration":%q is invalid, the "member_name" %q is already used by "ip_configuration":%q`, name, memberName, existing)
+		}
+		members[key] = name
+	}
+
+	for _, raw := range ipConfigurations {
+		if raw == nil {
+			continue
+		}
+		ipConfiguration := raw.(map[string]interface{})
+		subresourceName := ipConfiguration["subresource_name"].(string)
+		if ipConfiguration["member_name"].(string) == "" && configurationsPerSubresource[strings.ToLower(subresourceName)] > 1 {
+			return fmt.Errorf(`"ip_configuration":%q is invalid, the "member_name" attribute must be specified when multiple "ip_configuration" blocks target the same sub resource`, ipConfiguration["name"].(string))
+		}
+	}
+
 	return nil
 }
 
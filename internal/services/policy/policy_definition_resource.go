@@ -5,6 +5,7 @@ package policy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -45,12 +46,20 @@ func resourceArmPolicyDefinition() *pluginsdk.Resource {
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
 			// `parameters` cannot have values removed so we'll ForceNew if there are less parameters between Terraform runs
-			if d.HasChange("parameters") {
-				oldParametersRaw, newParametersRaw := d.GetChange("parameters")
-				if oldParametersString := oldParametersRaw.(string); oldParametersString != "" {
-					newParametersString := newParametersRaw.(string)
+			if d.HasChanges("parameters", "definition_json") && d.NewValueKnown("definition_json") {
+				oldParametersString, newParametersString, err := policyDefinitionParametersChange(d)
+				if err != nil {
+					return err
+				}
+
+				if oldParametersString != "" {
+					forceNewKey := "parameters"
+					if d.Get("definition_json").(string) != "" {
+						forceNewKey = "definition_json"
+					}
+
 					if newParametersString == "" {
-						return d.ForceNew("parameters")
+						return d.ForceNew(forceNewKey)
 					}
 
 					oldParameters, err := expandParameterDefinitionsValueFromString(oldParametersString)
@@ -64,7 +73,7 @@ func resourceArmPolicyDefinition() *pluginsdk.Resource {
 					}
 
 					if len(newParameters) < len(oldParameters) {
-						return d.ForceNew("parameters")
+						return d.ForceNew(forceNewKey)
 					}
 				}
 			}
@@ -114,7 +123,21 @@ func resourceArmPolicyDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Description: utils.String(description),
 	}
 
-	if policyRuleString := d.Get("policy_rule").(string); policyRuleString != "" {
+	policyRuleString := d.Get("policy_rule").(string)
+	metaDataString := d.Get("metadata").(string)
+	parametersString := d.Get("parameters").(string)
+	if v := d.Get("definition_json").(string); v != "" {
+		document, err := expandPolicyDefinitionJson(v)
+		if err != nil {
+			return fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+
+		if policyRuleString, parametersString, metaDataString, err = document.toStrings(); err != nil {
+			return fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+	}
+
+	if policyRuleString != "" {
 		policyRule, err := pluginsdk.ExpandJsonFromString(policyRuleString)
 		if err != nil {
 			return fmt.Errorf("expanding JSON for `policy_rule`: %+v", err)
@@ -122,7 +145,7 @@ func resourceArmPolicyDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta int
 		properties.PolicyRule = &policyRule
 	}
 
-	if metaDataString := d.Get("metadata").(string); metaDataString != "" {
+	if metaDataString != "" {
 		metaData, err := pluginsdk.ExpandJsonFromString(metaDataString)
 		if err != nil {
 			return fmt.Errorf("expanding JSON for `metadata`: %+v", err)
@@ -130,7 +153,7 @@ func resourceArmPolicyDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta int
 		properties.Metadata = &metaData
 	}
 
-	if parametersString := d.Get("parameters").(string); parametersString != "" {
+	if parametersString != "" {
 		parameters, err := expandParameterDefinitionsValueFromString(parametersString)
 		if err != nil {
 			return fmt.Errorf("expanding JSON for `parameters`: %+v", err)
@@ -235,21 +258,35 @@ func resourceArmPolicyDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("display_name", props.DisplayName)
 		d.Set("description", props.Description)
 
-		if policyRuleStr := flattenJSON(props.PolicyRule); policyRuleStr != "" {
-			d.Set("policy_rule", policyRuleStr)
+		policyRuleStr := flattenJSON(props.PolicyRule)
+		if policyRuleStr != "" {
 			roleIDs, _ := getPolicyRoleDefinitionIDs(policyRuleStr)
 			d.Set("role_definition_ids", roleIDs)
 		}
 
-		if metadataStr := flattenJSON(props.Metadata); metadataStr != "" {
+		metadataStr := flattenJSON(props.Metadata)
+		if metadataStr != "" {
 			d.Set("metadata", metadataStr)
 		}
 
-		if parametersStr, err := flattenParameterDefinitionsValueToString(props.Parameters); err == nil {
-			d.Set("parameters", parametersStr)
-		} else {
+		parametersStr, err := flattenParameterDefinitionsValueToString(props.Parameters)
+		if err != nil {
 			return fmt.Errorf("flattening policy definition parameters %+v", err)
 		}
+
+		// when the definition is managed using `definition_json` the rule and parameters are tracked within it instead
+		if d.Get("definition_json").(string) != "" {
+			definitionJson, err := flattenPolicyDefinitionJson(policyRuleStr, parametersStr, metadataStr)
+			if err != nil {
+				return fmt.Errorf("flattening `definition_json`: %+v", err)
+			}
+			d.Set("definition_json", definitionJson)
+		} else {
+			if policyRuleStr != "" {
+				d.Set("policy_rule", policyRuleStr)
+			}
+			d.Set("parameters", parametersStr)
+		}
 	}
 
 	return nil
@@ -375,6 +412,7 @@ func resourceArmPolicyDefinitionSchema() map[string]*pluginsdk.Schema {
 			Optional:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			ConflictsWith:    []string{"definition_json"},
 		},
 
 		"parameters": {
@@ -382,6 +420,15 @@ func resourceArmPolicyDefinitionSchema() map[string]*pluginsdk.Schema {
 			Optional:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			ConflictsWith:    []string{"definition_json"},
+		},
+
+		"definition_json": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: policyDefinitionJsonDiffSuppressFunc,
+			ConflictsWith:    []string{"policy_rule", "parameters", "metadata"},
 		},
 
 		"role_definition_ids": {
@@ -395,3 +442,132 @@ func resourceArmPolicyDefinitionSchema() map[string]*pluginsdk.Schema {
 		"metadata": metadataSchema(),
 	}
 }
+
+// policyDefinitionDocument is the subset of a standard Azure Policy Definition JSON document which can be
+// specified using `definition_json`.
+type policyDefinitionDocument struct {
+	PolicyRule interface{} `json:"policyRule,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+	Metadata   interface{} `json:"metadata,omitempty"`
+}
+
+// expandPolicyDefinitionJson parses a Policy Definition JSON document, accepting both the full format exported
+// from Azure (where the values are nested within `properties`) and the bare properties object.
+func expandPolicyDefinitionJson(input string) (*policyDefinitionDocument, error) {
+	var document struct {
+		Properties *policyDefinitionDocument `json:"properties"`
+		policyDefinitionDocument
+	}
+	if err := json.Unmarshal([]byte(input), &document); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %+v", err)
+	}
+
+	result := &document.policyDefinitionDocument
+	if document.Properties != nil {
+		result = document.Properties
+	}
+
+	if result.PolicyRule == nil {
+		return nil, fmt.Errorf("`policyRule` was not found")
+	}
+
+	return result, nil
+}
+
+// toStrings returns the JSON encoded `policyRule`, `parameters` and `metadata` of the document, which are
+// empty when they're not specified.
+func (d policyDefinitionDocument) toStrings() (string, string, string, error) {
+	values := []interface{}{d.PolicyRule, d.Parameters, d.Metadata}
+	results := make([]string, len(values))
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", "", "", err
+		}
+		results[i] = string(b)
+	}
+
+	return results[0], results[1], results[2], nil
+}
+
+func flattenPolicyDefinitionJson(policyRule, parameters, metadata string) (string, error) {
+	var document policyDefinitionDocument
+	inputs := []string{policyRule, parameters, metadata}
+	outputs := []*interface{}{&document.PolicyRule, &document.Parameters, &document.Metadata}
+	for i, input := range inputs {
+		if input == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(input), outputs[i]); err != nil {
+			return "", err
+		}
+	}
+
+	b, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// policyDefinitionJsonDiffSuppressFunc compares the `policyRule`, `parameters` and `metadata` of the documents
+// semantically, `metadata` is only compared when it's specified since Azure populates it otherwise.
+func policyDefinitionJsonDiffSuppressFunc(k, old, new string, d *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldDocument, err := expandPolicyDefinitionJson(old)
+	if err != nil {
+		return false
+	}
+	newDocument, err := expandPolicyDefinitionJson(new)
+	if err != nil {
+		return false
+	}
+
+	oldPolicyRule, oldParameters, oldMetadata, err := oldDocument.toStrings()
+	if err != nil {
+		return false
+	}
+	newPolicyRule, newParameters, newMetadata, err := newDocument.toStrings()
+	if err != nil {
+		return false
+	}
+
+	if oldPolicyRule != newPolicyRule || oldParameters != newParameters {
+		return false
+	}
+
+	return newMetadata == "" || metadataDiffSuppressFunc(k, oldMetadata, newMetadata, d)
+}
+
+// policyDefinitionParametersChange returns the old and new `parameters`, which are either specified directly or
+// within `definition_json`.
+func policyDefinitionParametersChange(d *pluginsdk.ResourceDiff) (string, string, error) {
+	oldParameters, newParameters := d.GetChange("parameters")
+	oldDefinitionJson, newDefinitionJson := d.GetChange("definition_json")
+
+	results := []string{oldParameters.(string), newParameters.(string)}
+	for i, v := range []string{oldDefinitionJson.(string), newDefinitionJson.(string)} {
+		if v == "" {
+			continue
+		}
+
+		document, err := expandPolicyDefinitionJson(v)
+		if err != nil {
+			return "", "", fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+
+		if _, results[i], _, err = document.toStrings(); err != nil {
+			return "", "", fmt.Errorf("expanding `definition_json`: %+v", err)
+		}
+	}
+
+	return results[0], results[1], nil
+}
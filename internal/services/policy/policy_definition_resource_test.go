@@ -168,6 +168,28 @@ func TestAccAzureRMPolicyDefinition_removeParameter(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPolicyDefinition_definitionJson(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_definition", "test")
+	r := PolicyDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.definitionJson(data, "audit"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("definition_json", "policy_rule", "parameters"),
+		{
+			Config: r.definitionJson(data, "deny"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("definition_json", "policy_rule", "parameters"),
+	})
+}
+
 func (r PolicyDefinitionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	definitionsClient := client.Policy.DefinitionsClient
 	id, err := parse.PolicyDefinitionID(state.ID)
@@ -543,3 +565,51 @@ PARAMETERS
 }
 `, data.RandomInteger, data.RandomInteger)
 }
+
+func (r PolicyDefinitionResource) definitionJson(data acceptance.TestData, effect string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%[1]d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%[1]d"
+
+  definition_json = <<DEFINITION
+{
+  "properties": {
+    "displayName": "acctestpol-%[1]d",
+    "mode": "All",
+    "metadata": {
+      "category": "General"
+    },
+    "parameters": {
+      "allowedLocations": {
+        "type": "Array",
+        "metadata": {
+          "description": "The list of allowed locations for resources.",
+          "displayName": "Allowed locations",
+          "strongType": "location"
+        }
+      }
+    },
+    "policyRule": {
+      "if": {
+        "not": {
+          "field": "location",
+          "in": "[parameters('allowedLocations')]"
+        }
+      },
+      "then": {
+        "effect": "%[2]s"
+      }
+    }
+  }
+}
+DEFINITION
+}
+`, data.RandomInteger, effect)
+}
@@ -266,7 +266,38 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 							}, false),
 						},
 
-						"standby_availability_zone": commonschema.ZoneSingleOptional(),
+						"standby_availability_zone": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"planned_failover": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"high_availability"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"trigger": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(serverrestart.FailoverModePlannedFailover),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(serverrestart.FailoverModePlannedFailover),
+								string(serverrestart.FailoverModeForcedFailover),
+							}, false),
+						},
 					},
 				},
 			},
@@ -890,6 +921,23 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	if d.HasChange("high_availability") {
+		// switching between `ZoneRedundant` and `SameZone` isn't supported by the API directly, so high availability is disabled first and then re-enabled with the new mode
+		oldMode, newMode := d.GetChange("high_availability.0.mode")
+		if oldMode.(string) != "" && newMode.(string) != "" && oldMode.(string) != newMode.(string) {
+			disabledMode := servers.HighAvailabilityModeDisabled
+			haParameters := servers.ServerForUpdate{
+				Properties: &servers.ServerPropertiesForUpdate{
+					HighAvailability: &servers.HighAvailability{
+						Mode: &disabledMode,
+					},
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, haParameters); err != nil {
+				return fmt.Errorf("disabling `high_availability` to switch `mode` for %s: %+v", *id, err)
+			}
+		}
+
 		parameters.Properties.HighAvailability = expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{}), false)
 	}
 
@@ -936,11 +984,17 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
+	failoverMode := serverrestart.FailoverModePlannedFailover
+	// any change to `planned_failover.0.trigger` fails the server over to its standby
+	if d.HasChange("planned_failover.0.trigger") && d.Get("planned_failover.0.trigger").(string) != "" {
+		requireFailover = true
+		failoverMode = serverrestart.FailoverMode(d.Get("planned_failover.0.mode").(string))
+	}
+
 	if requireFailover {
 		restartClient := meta.(*clients.Client).Postgres.ServerRestartClient
 
 		restartServerId := serverrestart.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
-		failoverMode := serverrestart.FailoverModePlannedFailover
 		restartParameters := serverrestart.RestartParameter{
 			RestartWithFailover: utils.Bool(true),
 			FailoverMode:        &failoverMode,
@@ -255,6 +255,65 @@ func TestAccPostgresqlFlexibleServer_failover(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlFlexibleServer_plannedFailover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.plannedFailover(data, "first", "PlannedFailover"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("high_availability.0.standby_availability_zone").IsSet(),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "planned_failover"),
+		{
+			Config: r.plannedFailover(data, "second", "PlannedFailover"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "planned_failover"),
+		{
+			Config: r.plannedFailover(data, "third", "ForcedFailover"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "planned_failover"),
+	})
+}
+
+func TestAccPostgresqlFlexibleServer_updateHighAvailabilityMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.highAvailabilityMode(data, "ZoneRedundant"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.highAvailabilityMode(data, "SameZone"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.highAvailabilityMode(data, "ZoneRedundant"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+	})
+}
+
 func TestAccPostgresqlFlexibleServer_geoRedundantBackupEnabled(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
 	r := PostgresqlFlexibleServerResource{}
@@ -1073,6 +1132,60 @@ resource "azurerm_postgresql_flexible_server" "test" {
 `, r.template(data), data.RandomInteger, primaryZone)
 }
 
+func (r PostgresqlFlexibleServerResource) plannedFailover(data acceptance.TestData, trigger string, mode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  version                = "12"
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  backup_retention_days  = 10
+  storage_mb             = 131072
+  sku_name               = "GP_Standard_D2s_v3"
+
+  high_availability {
+    mode = "ZoneRedundant"
+  }
+
+  planned_failover {
+    trigger = "%s"
+    mode    = "%s"
+  }
+
+  lifecycle {
+    ignore_changes = [zone]
+  }
+}
+`, r.template(data), data.RandomInteger, trigger, mode)
+}
+
+func (r PostgresqlFlexibleServerResource) highAvailabilityMode(data acceptance.TestData, mode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  version                = "12"
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  zone                   = "1"
+  backup_retention_days  = 10
+  storage_mb             = 131072
+  sku_name               = "GP_Standard_D2s_v3"
+
+  high_availability {
+    mode = "%s"
+  }
+}
+`, r.template(data), data.RandomInteger, mode)
+}
+
 func (r PostgresqlFlexibleServerResource) geoRedundantBackupEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
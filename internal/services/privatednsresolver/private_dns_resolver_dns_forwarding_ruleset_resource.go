@@ -6,24 +6,36 @@ package privatednsresolver
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type PrivateDNSResolverDnsForwardingRulesetModel struct {
-	Name                         string            `tfschema:"name"`
-	ResourceGroupName            string            `tfschema:"resource_group_name"`
-	DnsResolverOutboundEndpoints []string          `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
-	Location                     string            `tfschema:"location"`
-	Tags                         map[string]string `tfschema:"tags"`
+	Name                         string                                    `tfschema:"name"`
+	ResourceGroupName            string                                    `tfschema:"resource_group_name"`
+	DnsResolverOutboundEndpoints []string                                  `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
+	ForwardingRules              []DnsForwardingRulesetForwardingRuleModel `tfschema:"forwarding_rule"`
+	Location                     string                                    `tfschema:"location"`
+	Tags                         map[string]string                         `tfschema:"tags"`
+}
+
+type DnsForwardingRulesetForwardingRuleModel struct {
+	Name             string                 `tfschema:"name"`
+	DomainName       string                 `tfschema:"domain_name"`
+	Enabled          bool                   `tfschema:"enabled"`
+	Metadata         map[string]string      `tfschema:"metadata"`
+	TargetDnsServers []TargetDnsServerModel `tfschema:"target_dns_servers"`
 }
 
 type PrivateDNSResolverDnsForwardingRulesetResource struct{}
@@ -62,6 +74,60 @@ func (r PrivateDNSResolverDnsForwardingRulesetResource) Arguments() map[string]*
 			},
 		},
 
+		"forwarding_rule": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"domain_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ForwardingRuleDomainName,
+					},
+
+					"target_dns_servers": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"ip_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"port": {
+									Type:     pluginsdk.TypeInt,
+									Optional: true,
+									Default:  53,
+								},
+							},
+						},
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"metadata": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
 		"location": commonschema.Location(),
 
 		"tags": commonschema.Tags(),
@@ -110,6 +176,11 @@ func (r PrivateDNSResolverDnsForwardingRulesetResource) Create() sdk.ResourceFun
 			}
 
 			metadata.SetID(id)
+
+			if err := updateDnsForwardingRulesetForwardingRules(ctx, metadata.Client.PrivateDnsResolver.ForwardingRulesClient, id, nil, model.ForwardingRules); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -157,6 +228,14 @@ func (r PrivateDNSResolverDnsForwardingRulesetResource) Update() sdk.ResourceFun
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if metadata.ResourceData.HasChange("forwarding_rule") {
+				oldRaw, _ := metadata.ResourceData.GetChange("forwarding_rule")
+				existingRules := expandDnsForwardingRulesetForwardingRulesFromState(oldRaw.(*pluginsdk.Set).List())
+				if err := updateDnsForwardingRulesetForwardingRules(ctx, metadata.Client.PrivateDnsResolver.ForwardingRulesClient, *id, existingRules, model.ForwardingRules); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -201,6 +280,22 @@ func (r PrivateDNSResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc
 				state.Tags = *model.Tags
 			}
 
+			// only the forwarding rules defined inline are tracked, so that rules managed by the
+			// `azurerm_private_dns_resolver_forwarding_rule` resource don't show up as a diff
+			var existing PrivateDNSResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if len(existing.ForwardingRules) > 0 {
+				rules, err := metadata.Client.PrivateDnsResolver.ForwardingRulesClient.ListComplete(ctx, forwardingrules.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName), forwardingrules.DefaultListOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing Forwarding Rules for %s: %+v", *id, err)
+				}
+
+				state.ForwardingRules = flattenDnsForwardingRulesetForwardingRules(rules.Items, existing.ForwardingRules)
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -251,3 +346,142 @@ func flattenDnsResolverOutboundEndpoints(inputList *[]dnsforwardingrulesets.SubR
 
 	return outputList
 }
+
+// updateDnsForwardingRulesetForwardingRules reconciles the inline forwarding rules, only sending requests for rules which
+// have been added, changed or removed rather than for every rule in the ruleset
+func updateDnsForwardingRulesetForwardingRules(ctx context.Context, client *forwardingrules.ForwardingRulesClient, id dnsforwardingrulesets.DnsForwardingRulesetId, existing []DnsForwardingRulesetForwardingRuleModel, desired []DnsForwardingRulesetForwardingRuleModel) error {
+	existingByName := make(map[string]DnsForwardingRulesetForwardingRuleModel, len(existing))
+	for _, rule := range existing {
+		existingByName[rule.Name] = rule
+	}
+
+	desiredNames := make(map[string]struct{}, len(desired))
+	for _, rule := range desired {
+		desiredNames[rule.Name] = struct{}{}
+	}
+
+	for _, rule := range existing {
+		if _, ok := desiredNames[rule.Name]; ok {
+			continue
+		}
+
+		ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName, rule.Name)
+		if resp, err := client.Delete(ctx, ruleId, forwardingrules.DeleteOperationOptions{}); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+	}
+
+	for _, rule := range desired {
+		if current, ok := existingByName[rule.Name]; ok && reflect.DeepEqual(normalizeDnsForwardingRulesetForwardingRule(current), normalizeDnsForwardingRulesetForwardingRule(rule)) {
+			continue
+		}
+
+		ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName, rule.Name)
+		if _, err := client.CreateOrUpdate(ctx, ruleId, expandDnsForwardingRulesetForwardingRule(rule), forwardingrules.CreateOrUpdateOperationOptions{}); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", ruleId, err)
+		}
+	}
+
+	return nil
+}
+
+func normalizeDnsForwardingRulesetForwardingRule(input DnsForwardingRulesetForwardingRuleModel) DnsForwardingRulesetForwardingRuleModel {
+	if len(input.Metadata) == 0 {
+		input.Metadata = nil
+	}
+	if len(input.TargetDnsServers) == 0 {
+		input.TargetDnsServers = nil
+	}
+	return input
+}
+
+func expandDnsForwardingRulesetForwardingRule(input DnsForwardingRulesetForwardingRuleModel) forwardingrules.ForwardingRule {
+	state := forwardingrules.ForwardingRuleStateEnabled
+	if !input.Enabled {
+		state = forwardingrules.ForwardingRuleStateDisabled
+	}
+
+	metadata := input.Metadata
+	output := forwardingrules.ForwardingRule{
+		Properties: forwardingrules.ForwardingRuleProperties{
+			DomainName:          input.DomainName,
+			ForwardingRuleState: &state,
+			Metadata:            &metadata,
+		},
+	}
+
+	if targetDnsServers := expandTargetDnsServerModel(input.TargetDnsServers); targetDnsServers != nil {
+		output.Properties.TargetDnsServers = *targetDnsServers
+	}
+
+	return output
+}
+
+func expandDnsForwardingRulesetForwardingRulesFromState(input []interface{}) []DnsForwardingRulesetForwardingRuleModel {
+	output := make([]DnsForwardingRulesetForwardingRuleModel, 0)
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := DnsForwardingRulesetForwardingRuleModel{
+			Name:       v["name"].(string),
+			DomainName: v["domain_name"].(string),
+			Enabled:    v["enabled"].(bool),
+			Metadata:   map[string]string{},
+		}
+
+		for key, value := range v["metadata"].(map[string]interface{}) {
+			rule.Metadata[key] = value.(string)
+		}
+
+		for _, serverRaw := range v["target_dns_servers"].([]interface{}) {
+			server, ok := serverRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			rule.TargetDnsServers = append(rule.TargetDnsServers, TargetDnsServerModel{
+				IPAddress: server["ip_address"].(string),
+				Port:      int64(server["port"].(int)),
+			})
+		}
+
+		output = append(output, rule)
+	}
+
+	return output
+}
+
+func flattenDnsForwardingRulesetForwardingRules(input []forwardingrules.ForwardingRule, existing []DnsForwardingRulesetForwardingRuleModel) []DnsForwardingRulesetForwardingRuleModel {
+	output := make([]DnsForwardingRulesetForwardingRuleModel, 0)
+
+	rulesByName := make(map[string]forwardingrules.ForwardingRule, len(input))
+	for _, rule := range input {
+		if rule.Name != nil {
+			rulesByName[*rule.Name] = rule
+		}
+	}
+
+	for _, v := range existing {
+		rule, ok := rulesByName[v.Name]
+		if !ok {
+			continue
+		}
+
+		item := DnsForwardingRulesetForwardingRuleModel{
+			Name:             v.Name,
+			DomainName:       rule.Properties.DomainName,
+			Enabled:          rule.Properties.ForwardingRuleState != nil && *rule.Properties.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled,
+			TargetDnsServers: flattenTargetDnsServerModel(&rule.Properties.TargetDnsServers),
+		}
+
+		if rule.Properties.Metadata != nil {
+			item.Metadata = *rule.Properties.Metadata
+		}
+
+		output = append(output, item)
+	}
+
+	return output
+}
@@ -82,6 +82,38 @@ func TestAccPrivateDNSResolverDnsForwardingRuleset_update(t *testing.T) {
 	})
 }
 
+func TestAccPrivateDNSResolverDnsForwardingRuleset_forwardingRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_dns_forwarding_ruleset", "test")
+	r := PrivateDNSResolverDnsForwardingRulesetResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.forwardingRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forwarding_rule.#").HasValue("2"),
+			),
+		},
+		// inline forwarding rules aren't imported, as they can't be told apart from rules managed individually
+		data.ImportStep("forwarding_rule"),
+		{
+			Config: r.forwardingRulesUpdate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forwarding_rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("forwarding_rule"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("forwarding_rule.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r PrivateDNSResolverDnsForwardingRulesetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(state.ID)
 	if err != nil {
@@ -209,3 +241,79 @@ resource "azurerm_private_dns_resolver_dns_forwarding_ruleset" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) forwardingRules(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_dns_forwarding_ruleset" "test" {
+  name                                       = "acctest-drdfr-%d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  location                                   = azurerm_resource_group.test.location
+  private_dns_resolver_outbound_endpoint_ids = [azurerm_private_dns_resolver_outbound_endpoint.test.id]
+
+  forwarding_rule {
+    name        = "onprem"
+    domain_name = "onprem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+      port       = 53
+    }
+  }
+
+  forwarding_rule {
+    name        = "wildcard"
+    domain_name = "."
+    enabled     = false
+
+    target_dns_servers {
+      ip_address = "10.10.0.2"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) forwardingRulesUpdate(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_dns_forwarding_ruleset" "test" {
+  name                                       = "acctest-drdfr-%d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  location                                   = azurerm_resource_group.test.location
+  private_dns_resolver_outbound_endpoint_ids = [azurerm_private_dns_resolver_outbound_endpoint.test.id]
+
+  forwarding_rule {
+    name        = "onprem"
+    domain_name = "onprem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+      port       = 53
+    }
+
+    target_dns_servers {
+      ip_address = "10.10.0.3"
+      port       = 53
+    }
+
+    metadata = {
+      key = "value"
+    }
+  }
+
+  forwarding_rule {
+    name        = "corp"
+    domain_name = "corp.contoso.com."
+
+    target_dns_servers {
+      ip_address = "10.10.0.4"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
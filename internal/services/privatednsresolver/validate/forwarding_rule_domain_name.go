@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ForwardingRuleDomainName validates the domain name of a DNS Forwarding Rule, which must be a fully qualified domain
+// name ending with a period, or the wildcard domain `.` which matches every domain
+func ForwardingRuleDomainName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if value == "." {
+		return warnings, errors
+	}
+
+	if strings.HasPrefix(value, "*") {
+		errors = append(errors, fmt.Errorf("%q cannot contain a `*`, use `.` to forward all domains - a rule for a domain also applies to all of its subdomains", k))
+		return warnings, errors
+	}
+
+	if !strings.HasSuffix(value, ".") {
+		errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name ending with a period, got %q", k, value))
+		return warnings, errors
+	}
+
+	segments := strings.Split(strings.TrimSuffix(value, "."), ".")
+	if len(segments) > 34 {
+		errors = append(errors, fmt.Errorf("%q must contain at most 34 labels", k))
+		return warnings, errors
+	}
+
+	for _, segment := range segments {
+		if segment == "" {
+			errors = append(errors, fmt.Errorf("%q cannot contain consecutive periods", k))
+			return warnings, errors
+		}
+
+		if len(segment) > 63 {
+			errors = append(errors, fmt.Errorf("each label of %q must contain between 1 and 63 characters", k))
+			return warnings, errors
+		}
+
+		if !regexp.MustCompile(`^[a-zA-Z\d_-]+$`).MatchString(segment) {
+			errors = append(errors, fmt.Errorf("%q can only contain letters, numbers, underscores, dashes and periods", k))
+			return warnings, errors
+		}
+	}
+
+	return warnings, errors
+}
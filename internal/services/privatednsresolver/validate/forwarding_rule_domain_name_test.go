@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestForwardingRuleDomainName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  ".",
+			Errors: 0,
+		},
+		{
+			Value:  "*.",
+			Errors: 1,
+		},
+		{
+			Value:  "*.contoso.com.",
+			Errors: 1,
+		},
+		{
+			Value:  "contoso.com",
+			Errors: 1,
+		},
+		{
+			Value:  "contoso..com.",
+			Errors: 1,
+		},
+		{
+			Value:  "..",
+			Errors: 1,
+		},
+		{
+			Value:  "con$oso.com.",
+			Errors: 1,
+		},
+		{
+			Value:  strings.Repeat("a", 64) + ".com.",
+			Errors: 1,
+		},
+		{
+			Value:  strings.Repeat("a.", 35),
+			Errors: 1,
+		},
+		{
+			Value:  "local.",
+			Errors: 0,
+		},
+		{
+			Value:  "onprem.local.",
+			Errors: 0,
+		},
+		{
+			Value:  "_msdcs.corp-1.contoso.com.",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ForwardingRuleDomainName(tc.Value, "domain_name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("expected ForwardingRuleDomainName(%q) to return %d errors, got %d", tc.Value, tc.Errors, len(errors))
+		}
+	}
+}
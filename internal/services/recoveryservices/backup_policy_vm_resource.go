@@ -54,9 +54,22 @@ func resourceBackupProtectionPolicyVM() *pluginsdk.Resource {
 			_, hasDaily := diff.GetOk("retention_daily")
 			_, hasWeekly := diff.GetOk("retention_weekly")
 
+			policyType := diff.Get("policy_type").(string)
+			if days, ok := diff.GetOk("instant_restore_retention_days"); ok && policyType == string(protectionpolicies.IAASVMPolicyTypeVOne) && days.(int) > 5 {
+				return fmt.Errorf("`instant_restore_retention_days` must be less than or equal to `5` when `policy_type` is `V1`")
+			}
+
+			if err := validateBackupProtectionPolicyVMTieringPolicy(diff); err != nil {
+				return err
+			}
+
 			frequency, _ := diff.GetOk("backup.0.frequency")
 			switch frequency.(string) {
 			case string(protectionpolicies.ScheduleRunTypeHourly):
+				if policyType != string(protectionpolicies.IAASVMPolicyTypeVTwo) {
+					return fmt.Errorf("`backup.0.frequency` can only be `Hourly` when `policy_type` is `V2`")
+				}
+
 				if !hasDaily {
 					return fmt.Errorf("`retention_daily` must be set when backup.0.frequency is hourly")
 				}
@@ -64,6 +77,18 @@ func resourceBackupProtectionPolicyVM() *pluginsdk.Resource {
 				if _, ok := diff.GetOk("backup.0.weekdays"); ok {
 					return fmt.Errorf("`backup.0.weekdays` should be not set when backup.0.frequency is hourly")
 				}
+
+				if diff.NewValueKnown("backup.0.hour_interval") && diff.NewValueKnown("backup.0.hour_duration") {
+					interval := diff.Get("backup.0.hour_interval").(int)
+					duration := diff.Get("backup.0.hour_duration").(int)
+					if interval == 0 || duration == 0 {
+						return fmt.Errorf("`backup.0.hour_interval` and `backup.0.hour_duration` must be set when backup.0.frequency is hourly")
+					}
+
+					if duration%interval != 0 {
+						return fmt.Errorf("`backup.0.hour_duration` must be a multiple of `backup.0.hour_interval`")
+					}
+				}
 			case string(protectionpolicies.ScheduleRunTypeDaily):
 				if !hasDaily {
 					return fmt.Errorf("`retention_daily` must be set when backup.0.frequency is daily")
@@ -160,12 +185,7 @@ func resourceBackupProtectionPolicyVMCreateUpdate(d *pluginsdk.ResourceData, met
 	}
 
 	if d.HasChange("instant_restore_retention_days") {
-		days := d.Get("instant_restore_retention_days").(int)
-		if protectionpolicies.IAASVMPolicyTypeVOne == policyType && days > 5 {
-			return fmt.Errorf("`instant_restore_retention_days` must be less than or equal to `5` when `policy_type` is `V1`")
-		}
-
-		vmProtectionPolicyProperties.InstantRpRetentionRangeInDays = pointer.To(int64(days))
+		vmProtectionPolicyProperties.InstantRpRetentionRangeInDays = pointer.To(int64(d.Get("instant_restore_retention_days").(int)))
 	}
 
 	policy := protectionpolicies.ProtectionPolicyResource{
@@ -566,6 +586,29 @@ func expandBackupProtectionPolicyVMRetentionDailyFormat(block map[string]interfa
 	return &daily
 }
 
+func validateBackupProtectionPolicyVMTieringPolicy(diff *pluginsdk.ResourceDiff) error {
+	mode := diff.Get("tiering_policy.0.archived_restore_point.0.mode").(string)
+	if mode == "" || !diff.NewValueKnown("tiering_policy.0.archived_restore_point.0.duration") || !diff.NewValueKnown("tiering_policy.0.archived_restore_point.0.duration_type") {
+		return nil
+	}
+
+	duration := diff.Get("tiering_policy.0.archived_restore_point.0.duration").(int)
+	durationType := diff.Get("tiering_policy.0.archived_restore_point.0.duration_type").(string)
+
+	switch protectionpolicies.TieringMode(mode) {
+	case protectionpolicies.TieringModeTierAfter:
+		if duration == 0 || durationType == "" {
+			return fmt.Errorf("`duration` and `duration_type` must be set in `tiering_policy.0.archived_restore_point` when `mode` is `TierAfter`")
+		}
+	case protectionpolicies.TieringModeTierRecommended:
+		if duration != 0 || durationType != "" {
+			return fmt.Errorf("`duration` and `duration_type` cannot be set in `tiering_policy.0.archived_restore_point` when `mode` is `TierRecommended`")
+		}
+	}
+
+	return nil
+}
+
 func expandBackupProtectionPolicyVMTieringPolicy(input []interface{}) *map[string]protectionpolicies.TieringPolicy {
 	result := make(map[string]protectionpolicies.TieringPolicy)
 	if len(input) == 0 {
@@ -659,6 +702,10 @@ func flattenBackupProtectionPolicyVMScheduleV2(schedule protectionpolicies.Simpl
 	switch frequency {
 	case protectionpolicies.ScheduleRunTypeHourly:
 		schedule := schedule.HourlySchedule
+		if schedule == nil {
+			break
+		}
+
 		if schedule.Interval != nil {
 			block["hour_interval"] = *schedule.Interval
 		}
@@ -673,12 +720,20 @@ func flattenBackupProtectionPolicyVMScheduleV2(schedule protectionpolicies.Simpl
 		}
 	case protectionpolicies.ScheduleRunTypeDaily:
 		schedule := schedule.DailySchedule
+		if schedule == nil {
+			break
+		}
+
 		if times := schedule.ScheduleRunTimes; times != nil && len(*times) > 0 {
 			policyTime, _ := time.Parse(time.RFC3339, (*times)[0])
 			block["time"] = policyTime.Format("15:04")
 		}
 	case protectionpolicies.ScheduleRunTypeWeekly:
 		schedule := schedule.WeeklySchedule
+		if schedule == nil {
+			break
+		}
+
 		if days := schedule.ScheduleRunDays; days != nil {
 			weekdays := make([]interface{}, 0)
 			for _, d := range *days {
@@ -820,7 +875,8 @@ func flattenBackupProtectionPolicyVMTieringPolicy(input *map[string]protectionpo
 
 	for k, v := range *input {
 		if k == "ArchivedRP" {
-			if pointer.From(v.TieringMode) == protectionpolicies.TieringModeDoNotTier && pointer.From(v.DurationType) == protectionpolicies.RetentionDurationTypeInvalid && pointer.From(v.Duration) == 0 {
+			// the API returns `DoNotTier` (with or without an `Invalid` duration) when tiering isn't configured
+			if mode := pointer.From(v.TieringMode); mode == "" || mode == protectionpolicies.TieringModeDoNotTier {
 				return results
 			}
 
@@ -6,6 +6,7 @@ package recoveryservices_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectionpolicies"
@@ -593,6 +594,55 @@ func TestAccBackupProtectionPolicyVM_tieringPolicy(t *testing.T) {
 	})
 }
 
+func TestAccBackupProtectionPolicyVM_tieringPolicyHourlyV2(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm", "test")
+	r := BackupProtectionPolicyVMResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.completeHourly(data, "V2"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tieringPolicyHourlyV2(data, "TierAfter"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tieringPolicyHourlyV2(data, "TierRecommended"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completeHourly(data, "V2"),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tiering_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupProtectionPolicyVM_hourlyV1Invalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_policy_vm", "test")
+	r := BackupProtectionPolicyVMResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.basicHourly(data, "V1"),
+			ExpectError: regexp.MustCompile("`backup.0.frequency` can only be `Hourly` when `policy_type` is `V2`"),
+		},
+	})
+}
+
 func (t BackupProtectionPolicyVMResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := protectionpolicies.ParseBackupPolicyID(state.ID)
 	if err != nil {
@@ -1066,3 +1116,60 @@ resource "azurerm_backup_policy_vm" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r BackupProtectionPolicyVMResource) tieringPolicyHourlyV2(data acceptance.TestData, mode string) string {
+	archivedRestorePoint := `mode = "TierRecommended"`
+	if mode == "TierAfter" {
+		archivedRestorePoint = `mode          = "TierAfter"
+      duration      = 3
+      duration_type = "Months"`
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm" "test" {
+  name                           = "acctest-%d"
+  resource_group_name            = azurerm_resource_group.test.name
+  recovery_vault_name            = azurerm_recovery_services_vault.test.name
+  timezone                       = "UTC"
+  policy_type                    = "V2"
+  instant_restore_retention_days = 10
+
+  backup {
+    frequency     = "Hourly"
+    time          = "23:00"
+    hour_interval = 12
+    hour_duration = 24
+  }
+
+  retention_daily {
+    count = 10
+  }
+
+  retention_weekly {
+    count    = 42
+    weekdays = ["Sunday", "Wednesday"]
+  }
+
+  retention_monthly {
+    count    = 7
+    weekdays = ["Sunday", "Wednesday"]
+    weeks    = ["First", "Last"]
+  }
+
+  retention_yearly {
+    count    = 77
+    weekdays = ["Sunday", "Wednesday"]
+    weeks    = ["First", "Last"]
+    months   = ["January", "July"]
+  }
+
+  tiering_policy {
+    archived_restore_point {
+      %s
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, archivedRestorePoint)
+}
@@ -29,9 +29,11 @@ type VaultGuardProxyResource struct{}
 var _ sdk.Resource = VaultGuardProxyResource{}
 
 type VaultGuardProxyModel struct {
-	Name            string `tfschema:"name"`
-	VaultId         string `tfschema:"vault_id"`
-	ResourceGuardId string `tfschema:"resource_guard_id"`
+	Name                string   `tfschema:"name"`
+	VaultId             string   `tfschema:"vault_id"`
+	ResourceGuardId     string   `tfschema:"resource_guard_id"`
+	Description         string   `tfschema:"description"`
+	ProtectedOperations []string `tfschema:"protected_operations"`
 }
 
 func (r VaultGuardProxyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -61,11 +63,28 @@ func (r VaultGuardProxyResource) Arguments() map[string]*schema.Schema {
 		"vault_id": commonschema.ResourceIDReferenceRequiredForceNew(&vaults.VaultId{}),
 
 		"resource_guard_id": commonschema.ResourceIDReferenceRequiredForceNew(&resourceguards.ResourceGuardId{}),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
 	}
 }
 
 func (r VaultGuardProxyResource) Attributes() map[string]*schema.Schema {
-	return map[string]*schema.Schema{}
+	return map[string]*schema.Schema{
+		// the critical operations which require authorization from the Resource Guard, this is determined by the
+		// `vault_critical_operation_exclusion_list` of the Resource Guard
+		"protected_operations": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
 }
 func (r VaultGuardProxyResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
@@ -103,6 +122,10 @@ func (r VaultGuardProxyResource) Create() sdk.ResourceFunc {
 				}),
 			}
 
+			if plan.Description != "" {
+				proxy.Properties.Description = pointer.To(plan.Description)
+			}
+
 			if _, err = client.Put(ctx, id, proxy); err != nil {
 				return fmt.Errorf("creating %s:%w", id, err)
 			}
@@ -139,7 +162,10 @@ func (r VaultGuardProxyResource) Read() sdk.ResourceFunc {
 			}
 
 			if resp.Model != nil && resp.Model.Properties != nil {
-				state.ResourceGuardId = pointer.From(resp.Model.Properties.ResourceGuardResourceId)
+				props := resp.Model.Properties
+				state.ResourceGuardId = pointer.From(props.ResourceGuardResourceId)
+				state.Description = pointer.From(props.Description)
+				state.ProtectedOperations = flattenVaultGuardProxyOperationDetails(props.ResourceGuardOperationDetails)
 			}
 
 			return metadata.Encode(&state)
@@ -185,3 +211,18 @@ func (r VaultGuardProxyResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func flattenVaultGuardProxyOperationDetails(input *[]resourceguardproxy.ResourceGuardOperationDetail) []string {
+	operations := make([]string, 0)
+	if input == nil {
+		return operations
+	}
+
+	for _, detail := range *input {
+		if detail.VaultCriticalOperation != nil {
+			operations = append(operations, *detail.VaultCriticalOperation)
+		}
+	}
+
+	return operations
+}
@@ -33,6 +33,22 @@ func TestAccSiteRecoveryVaultResourceGuardAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestAccSiteRecoveryVaultResourceGuardAssociation_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_recovery_services_vault_resource_guard_association", "test")
+	r := VaultResourceGuardAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protected_operations.#").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (VaultResourceGuardAssociationResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -67,6 +83,42 @@ resource "azurerm_recovery_services_vault_resource_guard_association" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (VaultResourceGuardAssociationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-recovery-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  soft_delete_enabled = false
+}
+
+resource "azurerm_data_protection_resource_guard" "test" {
+  name                = "acctest-dprg-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  vault_critical_operation_exclusion_list = ["Microsoft.RecoveryServices/vaults/backupconfig/write"]
+}
+
+resource "azurerm_recovery_services_vault_resource_guard_association" "test" {
+  vault_id          = azurerm_recovery_services_vault.test.id
+  resource_guard_id = azurerm_data_protection_resource_guard.test.id
+  description       = "Multi-User Authorization for critical backup operations"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (t VaultResourceGuardAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := resourceguardproxy.ParseBackupResourceGuardProxyID(state.ID)
 	if err != nil {
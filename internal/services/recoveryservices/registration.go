@@ -37,6 +37,7 @@ func (r Registration) Resources() []sdk.Resource {
 		VMWareReplicationPolicyAssociationResource{},
 		VaultGuardProxyResource{},
 		VMWareReplicatedVmResource{},
+		SiteRecoveryReplicatedVMTestFailoverResource{},
 	}
 }
 
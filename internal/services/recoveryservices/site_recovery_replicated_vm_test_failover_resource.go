@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SiteRecoveryReplicatedVMTestFailoverModel struct {
+	ReplicatedVmId                 string            `tfschema:"replicated_vm_id"`
+	NetworkId                      string            `tfschema:"network_id"`
+	RecoveryPointId                string            `tfschema:"recovery_point_id"`
+	CleanupComments                string            `tfschema:"cleanup_comments"`
+	Triggers                       map[string]string `tfschema:"triggers"`
+	TestFailoverState              string            `tfschema:"test_failover_state"`
+	LastSuccessfulTestFailoverTime string            `tfschema:"last_successful_test_failover_time"`
+}
+
+// SiteRecoveryReplicatedVMTestFailoverResource runs a Test Failover of a replicated VM when created and cleans it
+// up when destroyed, allowing disaster recovery drills to be performed as part of a pipeline.
+type SiteRecoveryReplicatedVMTestFailoverResource struct{}
+
+var _ sdk.ResourceWithUpdate = SiteRecoveryReplicatedVMTestFailoverResource{}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) ModelObject() interface{} {
+	return &SiteRecoveryReplicatedVMTestFailoverModel{}
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) ResourceType() string {
+	return "azurerm_site_recovery_replicated_vm_test_failover"
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return replicationprotecteditems.ValidateReplicationProtectedItemID
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"replicated_vm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: replicationprotecteditems.ValidateReplicationProtectedItemID,
+		},
+
+		"network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualNetworkID,
+		},
+
+		"recovery_point_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cleanup_comments": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1024),
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"test_failover_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_successful_test_failover_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectedItemsClient
+
+			var model SiteRecoveryReplicatedVMTestFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := replicationprotecteditems.ParseReplicationProtectedItemID(model.ReplicatedVmId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && testFailoverIsActive(existing.Model.Properties.TestFailoverState) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			providerInput := replicationprotecteditems.A2ATestFailoverInput{}
+			if model.RecoveryPointId != "" {
+				providerInput.RecoveryPointId = pointer.To(model.RecoveryPointId)
+			}
+
+			input := replicationprotecteditems.TestFailoverInput{
+				Properties: replicationprotecteditems.TestFailoverInputProperties{
+					FailoverDirection:       pointer.To("PrimaryToRecovery"),
+					NetworkType:             pointer.To("VmNetworkAsInput"),
+					NetworkId:               pointer.To(model.NetworkId),
+					ProviderSpecificDetails: providerInput,
+				},
+			}
+
+			if err := client.TestFailoverThenPoll(ctx, *id, input); err != nil {
+				return fmt.Errorf("running test failover for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectedItemsClient
+
+			id, err := replicationprotecteditems.ParseReplicationProtectedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state SiteRecoveryReplicatedVMTestFailoverModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state.ReplicatedVmId = id.ID()
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					// the test failover has been cleaned up outside of Terraform
+					if !testFailoverIsActive(props.TestFailoverState) {
+						return metadata.MarkAsGone(id)
+					}
+
+					state.TestFailoverState = pointer.From(props.TestFailoverState)
+					state.LastSuccessfulTestFailoverTime = pointer.From(props.LastSuccessfulTestFailoverTime)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// only `cleanup_comments` can be updated, which is used when the test failover is cleaned up
+			return nil
+		},
+	}
+}
+
+func (r SiteRecoveryReplicatedVMTestFailoverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectedItemsClient
+
+			id, err := replicationprotecteditems.ParseReplicationProtectedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SiteRecoveryReplicatedVMTestFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			input := replicationprotecteditems.TestFailoverCleanupInput{
+				Properties: replicationprotecteditems.TestFailoverCleanupInputProperties{},
+			}
+			if model.CleanupComments != "" {
+				input.Properties.Comments = pointer.To(model.CleanupComments)
+			}
+
+			if err := client.TestFailoverCleanupThenPoll(ctx, *id, input); err != nil {
+				return fmt.Errorf("cleaning up test failover for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func testFailoverIsActive(state *string) bool {
+	v := pointer.From(state)
+	return v != "" && !strings.EqualFold(v, "None")
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SiteRecoveryReplicatedVMTestFailoverResource struct{}
+
+func TestAccSiteRecoveryReplicatedVMTestFailover_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replicated_vm_test_failover", "test")
+	r := SiteRecoveryReplicatedVMTestFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("test_failover_state").Exists(),
+			),
+		},
+		data.ImportStep("network_id", "cleanup_comments", "triggers"),
+		{
+			// changing the triggers re-runs the drill
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("network_id", "cleanup_comments", "triggers"),
+	})
+}
+
+func (SiteRecoveryReplicatedVMTestFailoverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := replicationprotecteditems.ParseReplicationProtectedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ReplicationProtectedItemsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return pointer.To(false), nil
+	}
+
+	testFailoverState := pointer.From(resp.Model.Properties.TestFailoverState)
+	return pointer.To(testFailoverState != "" && !strings.EqualFold(testFailoverState, "None")), nil
+}
+
+func (SiteRecoveryReplicatedVMTestFailoverResource) basic(data acceptance.TestData, drill string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "test_failover" {
+  name                = "net-%[2]d-tfo"
+  resource_group_name = azurerm_resource_group.test2.name
+  address_space       = ["192.168.3.0/24"]
+  location            = azurerm_site_recovery_fabric.test2.location
+}
+
+resource "azurerm_subnet" "test_failover" {
+  name                 = "snet-%[2]d-tfo"
+  resource_group_name  = azurerm_resource_group.test2.name
+  virtual_network_name = azurerm_virtual_network.test_failover.name
+  address_prefixes     = ["192.168.3.0/24"]
+}
+
+resource "azurerm_site_recovery_replicated_vm_test_failover" "test" {
+  replicated_vm_id = azurerm_site_recovery_replicated_vm.test.id
+  network_id       = azurerm_virtual_network.test_failover.id
+  cleanup_comments = "acceptance test drill"
+
+  triggers = {
+    drill = "%[3]s"
+  }
+
+  depends_on = [azurerm_subnet.test_failover]
+}
+`, SiteRecoveryReplicatedVmResource{}.basic(data), data.RandomInteger, drill)
+}
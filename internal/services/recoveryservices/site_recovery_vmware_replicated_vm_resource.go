@@ -311,6 +311,13 @@ func (k VMWareReplicatedVmResource) CustomizeDiff() sdk.ResourceFunc {
 				}
 			}
 
+			// the test network can be changed but not removed once set, so removing it forces new.
+			if oldTestNetworkId, newTestNetworkId := diff.GetChange("test_network_id"); oldTestNetworkId.(string) != "" && newTestNetworkId.(string) == "" {
+				if err := metadata.ResourceDiff.ForceNew("test_network_id"); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 		Timeout: 30 * time.Minute,
@@ -660,6 +667,14 @@ func (s VMWareReplicatedVmResource) Update() sdk.ResourceFunc {
 				updateInput.TargetNetworkId = existingDetails.TargetNetworkId
 			}
 
+			if metadata.ResourceData.HasChange("test_network_id") {
+				if model.TestNetworkId != "" {
+					updateInput.TestNetworkId = &model.TestNetworkId
+				}
+			} else {
+				updateInput.TestNetworkId = existingDetails.TestNetworkId
+			}
+
 			if metadata.ResourceData.HasChange("target_proximity_placement_group_id") {
 				updateInput.TargetProximityPlacementGroupId = &model.TargetProximityPlacementGroupId
 			} else {
@@ -694,6 +709,8 @@ func (s VMWareReplicatedVmResource) Update() sdk.ResourceFunc {
 				props.SelectedRecoveryAzureNetworkId = existingDetails.TargetNetworkId
 			}
 
+			props.SelectedTfoAzureNetworkId = updateInput.TestNetworkId
+
 			if metadata.ResourceData.HasChange("target_vm_size") {
 				props.RecoveryAzureVMSize = &model.TargetVmSize
 			} else {
@@ -113,7 +113,59 @@ func TestAccSiteVMWareRecoveryReplicatedVM_basic(t *testing.T) {
 	})
 }
 
+func TestAccSiteVMWareRecoveryReplicatedVM_testFailoverNetwork(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_vmware_replicated_vm", "test")
+	r, err := newSiteRecoveryVMWareReplicatedVMResource(
+		os.Getenv("ARM_TEST_VMWARE_VAULT_ID"),
+		os.Getenv("ARM_TEST_VMWARE_SOURCE_VM_NAME"),
+		os.Getenv("ARM_TEST_VMWARE_APPLIANCE_NAME"),
+		os.Getenv("ARM_TEST_VMWARE_VAULT_LOCATION"),
+		os.Getenv("ARM_TEST_VMWARE_CREDENTIAL_NAME"),
+		os.Getenv("ARM_TEST_VMWARE_SOURCE_MAC_ADDRESS"),
+	)
+	if err != nil {
+		t.Skipf("failed to create SiteRecoveryVMWareReplicatedVmResource: %+v", err)
+	}
+
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.testFailoverNetwork(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("test_network_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the test network forces a new resource
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("test_network_id").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SiteRecoveryVMWareReplicatedVmResource) basic(data acceptance.TestData) string {
+	return r.config(data, "")
+}
+
+func (r SiteRecoveryVMWareReplicatedVmResource) testFailoverNetwork(data acceptance.TestData) string {
+	return r.config(data, "test_network_id                            = azurerm_virtual_network.test_failover.id")
+}
+
+func (r SiteRecoveryVMWareReplicatedVmResource) config(data acceptance.TestData, testNetwork string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   subscription_id = "%[1]s"
@@ -148,6 +200,13 @@ resource "azurerm_subnet" "target" {
   address_prefixes     = ["10.0.2.0/24"]
 }
 
+resource "azurerm_virtual_network" "test_failover" {
+  name                = "acctestvn-tfo-%[4]d"
+  address_space       = ["10.1.0.0/16"]
+  location            = azurerm_resource_group.target.location
+  resource_group_name = azurerm_resource_group.target.name
+}
+
 data "azurerm_recovery_services_vault" "vault" {
   name                = "%[2]s"
   resource_group_name = "%[3]s"
@@ -180,6 +239,7 @@ resource "azurerm_site_recovery_vmware_replicated_vm" "test" {
   default_log_storage_account_id             = azurerm_storage_account.target.id
   default_recovery_disk_type                 = "Standard_LRS"
   target_network_id                          = azurerm_virtual_network.target.id
+  %[11]s
 
   network_interface {
     source_mac_address = "%[10]s"
@@ -197,5 +257,5 @@ resource "azurerm_site_recovery_vmware_replicated_vm" "test" {
     ]
   }
 }
-`, r.SubscriptionId, r.VaultName, r.VaultRgName, data.RandomInteger, data.RandomString, r.Location, r.SourceVMName, r.ApplianceName, r.Credential, r.SourceMacAddress)
+`, r.SubscriptionId, r.VaultName, r.VaultRgName, data.RandomInteger, data.RandomString, r.Location, r.SourceVMName, r.ApplianceName, r.Credential, r.SourceMacAddress, testNetwork)
 }
@@ -290,6 +290,18 @@ func resourceRedisCache() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"rotate_primary_key_on": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"rotate_secondary_key_on": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"primary_access_key": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -373,6 +385,29 @@ func resourceRedisCache() *pluginsdk.Resource {
 				}
 				return false
 			}),
+			// the access keys and connection strings are regenerated when the corresponding trigger changes
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if diff.Id() == "" {
+					return nil
+				}
+
+				for trigger, keyPrefix := range map[string]string{
+					"rotate_primary_key_on":   "primary",
+					"rotate_secondary_key_on": "secondary",
+				} {
+					if !diff.HasChange(trigger) || diff.Get(trigger).(string) == "" {
+						continue
+					}
+
+					for _, key := range []string{keyPrefix + "_access_key", keyPrefix + "_connection_string"} {
+						if err := diff.SetNewComputed(key); err != nil {
+							return fmt.Errorf("setting `%s` to computed: %+v", key, err)
+						}
+					}
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -637,6 +672,21 @@ func resourceRedisCacheUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	// the access keys are regenerated whenever the value of the corresponding trigger changes
+	keyRotations := map[string]redis.RedisKeyType{
+		"rotate_primary_key_on":   redis.RedisKeyTypePrimary,
+		"rotate_secondary_key_on": redis.RedisKeyTypeSecondary,
+	}
+	for field, keyType := range keyRotations {
+		if !d.HasChange(field) || d.Get(field).(string) == "" {
+			continue
+		}
+
+		if _, err := client.RegenerateKey(ctx, *id, redis.RedisRegenerateKeyParameters{KeyType: keyType}); err != nil {
+			return fmt.Errorf("regenerating the %s access key for %s: %+v", strings.ToLower(string(keyType)), *id, err)
+		}
+	}
+
 	return resourceRedisCacheRead(d, meta)
 }
 
@@ -782,6 +832,13 @@ func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		defer locks.UnlockByName(parsed.SubnetName, network.SubnetResourceName)
 	}
 
+	if meta.(*clients.Client).Features.RedisCache.FlushDataOnDestroy {
+		log.Printf("[DEBUG] Flushing the data in %s prior to deletion", *id)
+		if err := client.FlushCacheThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("flushing the data in %s: %+v", *id, err)
+		}
+	}
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
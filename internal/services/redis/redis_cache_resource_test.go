@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2023-08-01/redis"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -364,6 +365,57 @@ func TestAccRedisCache_PublicNetworkAccessDisabledEnabled(t *testing.T) {
 	})
 }
 
+func TestAccRedisCache_rotateAccessKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache", "test")
+	r := RedisCacheResource{}
+	var primaryKey, secondaryKey string
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotateAccessKeys(data, "first", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.keyValueChanged(data.ResourceName, "primary_access_key", &primaryKey),
+				r.keyValueChanged(data.ResourceName, "secondary_access_key", &secondaryKey),
+			),
+		},
+		data.ImportStep("rotate_primary_key_on", "rotate_secondary_key_on"),
+		{
+			Config: r.rotateAccessKeys(data, "second", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.keyValueChanged(data.ResourceName, "primary_access_key", &primaryKey),
+				r.keyValueUnchanged(data.ResourceName, "secondary_access_key", &secondaryKey),
+			),
+		},
+		data.ImportStep("rotate_primary_key_on", "rotate_secondary_key_on"),
+		{
+			Config: r.rotateAccessKeys(data, "second", "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.keyValueUnchanged(data.ResourceName, "primary_access_key", &primaryKey),
+				r.keyValueChanged(data.ResourceName, "secondary_access_key", &secondaryKey),
+			),
+		},
+		data.ImportStep("rotate_primary_key_on", "rotate_secondary_key_on"),
+	})
+}
+
+func TestAccRedisCache_flushDataOnDestroy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache", "test")
+	r := RedisCacheResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.flushDataOnDestroy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccRedisCache_InternalSubnet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_redis_cache", "test")
 	r := RedisCacheResource{}
@@ -602,6 +654,101 @@ resource "azurerm_redis_cache" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, !requireSSL)
 }
 
+// keyValueUnchanged checks that the value of the specified key matches the previous value
+func (RedisCacheResource) keyValueUnchanged(resourceName, key string, previous *string) pluginsdk.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		if current := rs.Primary.Attributes[key]; current != *previous {
+			return fmt.Errorf("expected `%s` not to have been regenerated", key)
+		}
+
+		return nil
+	}
+}
+
+// keyValueChanged checks that the value of the specified key differs from the previous value, which is then updated
+func (RedisCacheResource) keyValueChanged(resourceName, key string, previous *string) pluginsdk.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		current := rs.Primary.Attributes[key]
+		if current == "" {
+			return fmt.Errorf("expected `%s` to be set", key)
+		}
+		if current == *previous {
+			return fmt.Errorf("expected `%s` to have been regenerated", key)
+		}
+
+		*previous = current
+		return nil
+	}
+}
+
+func (RedisCacheResource) rotateAccessKeys(data acceptance.TestData, primaryTrigger, secondaryTrigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_redis_cache" "test" {
+  name                    = "acctestRedis-%[1]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  capacity                = 1
+  family                  = "C"
+  sku_name                = "Basic"
+  minimum_tls_version     = "1.2"
+  rotate_primary_key_on   = "%[3]s"
+  rotate_secondary_key_on = "%[4]s"
+
+  redis_configuration {
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, primaryTrigger, secondaryTrigger)
+}
+
+func (RedisCacheResource) flushDataOnDestroy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    redis_cache {
+      flush_data_on_destroy = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_redis_cache" "test" {
+  name                = "acctestRedis-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  capacity            = 1
+  family              = "C"
+  sku_name            = "Standard"
+  minimum_tls_version = "1.2"
+
+  redis_configuration {
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (RedisCacheResource) managedIdentityAuth(data acceptance.TestData, requireSSL bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
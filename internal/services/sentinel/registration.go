@@ -31,8 +31,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_sentinel_alert_rule":          dataSourceSentinelAlertRule(),
-		"azurerm_sentinel_alert_rule_template": dataSourceSentinelAlertRuleTemplate(),
+		"azurerm_sentinel_alert_rule":           dataSourceSentinelAlertRule(),
+		"azurerm_sentinel_alert_rule_template":  dataSourceSentinelAlertRuleTemplate(),
+		"azurerm_sentinel_alert_rule_templates": dataSourceSentinelAlertRuleTemplates(),
 	}
 }
 
@@ -54,6 +54,22 @@ func importSentinelAlertRuleForTypedSdk(expectKind alertrules.AlertRuleKind) sdk
 	}
 }
 
+// alertRuleTemplateVersionCustomizeDiff surfaces a diff when Microsoft has published a newer version of the alert rule
+// template than the `alert_rule_template_version` the rule is pinned to, this diff remains until the version is updated
+func alertRuleTemplateVersionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || d.HasChange("alert_rule_template_version") {
+		return nil
+	}
+
+	templateVersion := d.Get("alert_rule_template_version").(string)
+	latestTemplateVersion := d.Get("latest_alert_rule_template_version").(string)
+	if templateVersion == "" || latestTemplateVersion == "" || templateVersion == latestTemplateVersion {
+		return nil
+	}
+
+	return d.SetNewComputed("latest_alert_rule_template_version")
+}
+
 func assertAlertRuleKind(rule *alertrules.AlertRule, expectKind alertrules.AlertRuleKind) error {
 	if rule == nil {
 		return fmt.Errorf("model was nil")
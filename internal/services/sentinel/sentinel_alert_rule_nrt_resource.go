@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,6 +34,8 @@ func resourceSentinelAlertRuleNrt() *pluginsdk.Resource {
 			return err
 		}, importSentinelAlertRule(alertrules.AlertRuleKindNRT)),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(alertRuleTemplateVersionCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -74,6 +77,11 @@ func resourceSentinelAlertRuleNrt() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"latest_alert_rule_template_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -475,6 +483,17 @@ func resourceSentinelAlertRuleNrtRead(d *pluginsdk.ResourceData, meta interface{
 			d.Set("alert_rule_template_guid", prop.AlertRuleTemplateName)
 			d.Set("alert_rule_template_version", prop.TemplateVersion)
 
+			latestTemplateVersion := ""
+			if templateName := pointer.From(prop.AlertRuleTemplateName); templateName != "" {
+				// the template may have been removed from the workspace or be inaccessible, which shouldn't prevent the rule being read
+				if v, err := getAlertRuleTemplateVersion(ctx, meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient, id.ResourceGroupName, id.WorkspaceName, templateName); err != nil {
+					log.Printf("[WARN] retrieving the latest version of Alert Rule Template %q for %s: %+v", templateName, id, err)
+				} else {
+					latestTemplateVersion = v
+				}
+			}
+			d.Set("latest_alert_rule_template_version", latestTemplateVersion)
+
 			if err := d.Set("event_grouping", flattenAlertRuleScheduledEventGroupingSetting(prop.EventGroupingSettings)); err != nil {
 				return fmt.Errorf("setting `event_grouping`: %+v", err)
 			}
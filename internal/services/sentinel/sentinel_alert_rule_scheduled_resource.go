@@ -36,6 +36,8 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 			return err
 		}, importSentinelAlertRule(alertrules.AlertRuleKindScheduled)),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(alertRuleTemplateVersionCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -76,6 +78,11 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"latest_alert_rule_template_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -627,6 +634,17 @@ func resourceSentinelAlertRuleScheduledRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("alert_rule_template_guid", prop.AlertRuleTemplateName)
 			d.Set("alert_rule_template_version", prop.TemplateVersion)
 
+			latestTemplateVersion := ""
+			if templateName := pointer.From(prop.AlertRuleTemplateName); templateName != "" {
+				// the template may have been removed from the workspace or be inaccessible, which shouldn't prevent the rule being read
+				if v, err := getAlertRuleTemplateVersion(ctx, meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient, id.ResourceGroupName, id.WorkspaceName, templateName); err != nil {
+					log.Printf("[WARN] retrieving the latest version of Alert Rule Template %q for %s: %+v", templateName, id, err)
+				} else {
+					latestTemplateVersion = v
+				}
+			}
+			d.Set("latest_alert_rule_template_version", latestTemplateVersion)
+
 			if err := d.Set("event_grouping", flattenAlertRuleScheduledEventGroupingSetting(prop.EventGroupingSettings)); err != nil {
 				return fmt.Errorf("setting `event_grouping`: %+v", err)
 			}
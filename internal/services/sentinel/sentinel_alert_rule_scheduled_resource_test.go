@@ -124,6 +124,7 @@ func TestAccSentinelAlertRuleScheduled_withAlertRuleTemplateGuid(t *testing.T) {
 			Config: r.alertRuleTemplateGuid(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("latest_alert_rule_template_version").IsNotEmpty(),
 			),
 		},
 		data.ImportStep(),
@@ -94,6 +94,10 @@ func dataSourceSentinelAlertRuleTemplate() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeInt,
 							Computed: true,
 						},
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -139,6 +143,10 @@ func dataSourceSentinelAlertRuleTemplate() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
 						},
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -353,6 +361,11 @@ func flattenScheduledAlertRuleTemplate(input *securityinsight.ScheduledAlertRule
 		triggerThreshold = int(*input.TriggerThreshold)
 	}
 
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"description":       description,
@@ -363,6 +376,7 @@ func flattenScheduledAlertRuleTemplate(input *securityinsight.ScheduledAlertRule
 			"query_period":      queryPeriod,
 			"trigger_operator":  string(input.TriggerOperator),
 			"trigger_threshold": triggerThreshold,
+			"version":           version,
 		},
 	}
 }
@@ -387,12 +401,18 @@ func flattenNrtAlertRuleTemplate(input *securityinsight.NrtAlertRuleTemplateProp
 		query = *input.Query
 	}
 
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"description": description,
 			"tactics":     tactics,
 			"severity":    string(input.Severity),
 			"query":       query,
+			"version":     version,
 		},
 	}
 }
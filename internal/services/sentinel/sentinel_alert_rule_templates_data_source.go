@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceSentinelAlertRuleTemplates() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSentinelAlertRuleTemplatesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: workspaces.ValidateWorkspaceID,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(securityinsight.KindBasicAlertRuleTemplateKindFusion),
+					string(securityinsight.KindBasicAlertRuleTemplateKindMicrosoftSecurityIncidentCreation),
+					string(securityinsight.KindBasicAlertRuleTemplateKindMLBehaviorAnalytics),
+					string(securityinsight.KindBasicAlertRuleTemplateKindNRT),
+					string(securityinsight.KindBasicAlertRuleTemplateKindScheduled),
+					string(securityinsight.KindBasicAlertRuleTemplateKindThreatIntelligence),
+				}, false),
+			},
+
+			"templates": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"kind": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSentinelAlertRuleTemplatesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := workspaces.ParseWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	templates, err := listAlertRuleTemplates(ctx, client, workspaceId, d.Get("kind").(string))
+	if err != nil {
+		return fmt.Errorf("listing Alert Rule Templates for %s: %+v", *workspaceId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/providers/Microsoft.SecurityInsights/alertRuleTemplates", workspaceId.ID()))
+	d.Set("log_analytics_workspace_id", workspaceId.ID())
+
+	if err := d.Set("templates", templates); err != nil {
+		return fmt.Errorf("setting `templates`: %+v", err)
+	}
+
+	return nil
+}
+
+func listAlertRuleTemplates(ctx context.Context, client *securityinsight.AlertRuleTemplatesClient, workspaceId *workspaces.WorkspaceId, kind string) ([]interface{}, error) {
+	iterator, err := client.ListComplete(ctx, workspaceId.ResourceGroupName, workspaceId.WorkspaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, 0)
+	for iterator.NotDone() {
+		template := flattenAlertRuleTemplateSummary(iterator.Value())
+		if template != nil && (kind == "" || template["kind"] == kind) {
+			results = append(results, template)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("iterating Alert Rule Templates: %+v", err)
+		}
+	}
+
+	return results, nil
+}
+
+// getAlertRuleTemplateVersion returns the version Microsoft currently publishes for the named template,
+// or an empty string when the template doesn't exist (any more) or its kind isn't versioned.
+func getAlertRuleTemplateVersion(ctx context.Context, client *securityinsight.AlertRuleTemplatesClient, resourceGroupName, workspaceName, name string) (string, error) {
+	resp, err := client.Get(ctx, resourceGroupName, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if template := flattenAlertRuleTemplateSummary(resp.Value); template != nil {
+		return template["version"].(string), nil
+	}
+
+	return "", nil
+}
+
+func flattenAlertRuleTemplateSummary(input securityinsight.BasicAlertRuleTemplate) map[string]interface{} {
+	var name, displayName, version *string
+	var kind securityinsight.KindBasicAlertRuleTemplate
+	var status securityinsight.TemplateStatus
+
+	switch template := input.(type) {
+	case securityinsight.FusionAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if props := template.FusionAlertRuleTemplateProperties; props != nil {
+			displayName, status = props.DisplayName, props.Status
+		}
+	case securityinsight.MLBehaviorAnalyticsAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if props := template.MLBehaviorAnalyticsAlertRuleTemplateProperties; props != nil {
+			displayName, status = props.DisplayName, props.Status
+		}
+	case securityinsight.MicrosoftSecurityIncidentCreationAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if props := template.MicrosoftSecurityIncidentCreationAlertRuleTemplateProperties; props != nil {
+			displayName, status = props.DisplayName, props.Status
+		}
+	case securityinsight.ScheduledAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if props := template.ScheduledAlertRuleTemplateProperties; props != nil {
+			displayName, status, version = props.DisplayName, props.Status, props.Version
+		}
+	case securityinsight.NrtAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if props := template.NrtAlertRuleTemplateProperties; props != nil {
+			displayName, status, version = props.DisplayName, props.Status, props.Version
+		}
+	case securityinsight.ThreatIntelligenceAlertRuleTemplate:
+		name, kind = template.Name, template.Kind
+		if props := template.ThreatIntelligenceAlertRuleTemplateProperties; props != nil {
+			displayName, status = props.DisplayName, props.Status
+		}
+	default:
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"name":         "",
+		"display_name": "",
+		"kind":         string(kind),
+		"status":       string(status),
+		"version":      "",
+	}
+	if name != nil {
+		result["name"] = *name
+	}
+	if displayName != nil {
+		result["display_name"] = *displayName
+	}
+	if version != nil {
+		result["version"] = *version
+	}
+
+	return result
+}
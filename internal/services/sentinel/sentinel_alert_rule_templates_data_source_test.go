@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SentinelAlertRuleTemplatesDataSource struct{}
+
+func TestAccSentinelAlertRuleTemplatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_alert_rule_templates", "test")
+	r := SentinelAlertRuleTemplatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("templates.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("templates.0.name").Exists(),
+				check.That(data.ResourceName).Key("templates.0.display_name").Exists(),
+				check.That(data.ResourceName).Key("templates.0.kind").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccSentinelAlertRuleTemplatesDataSource_kind(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_alert_rule_templates", "test")
+	r := SentinelAlertRuleTemplatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.kind(data, "Scheduled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("templates.0.kind").HasValue("Scheduled"),
+				check.That(data.ResourceName).Key("templates.0.version").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (r SentinelAlertRuleTemplatesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_alert_rule_templates" "test" {
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+}
+`, r.template(data))
+}
+
+func (r SentinelAlertRuleTemplatesDataSource) kind(data acceptance.TestData, kind string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sentinel_alert_rule_templates" "test" {
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  kind                       = "%s"
+}
+`, r.template(data), kind)
+}
+
+func (SentinelAlertRuleTemplatesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
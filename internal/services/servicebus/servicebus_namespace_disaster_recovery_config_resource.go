@@ -68,6 +68,37 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"failover": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"trigger": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"safe_failover_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"active_namespace_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"primary_connection_string_alias": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -152,26 +183,51 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigUpdate(d *pluginsdk.Resour
 	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
 	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
 
+	failoverTriggered := d.HasChange("failover.0.trigger") && d.Get("failover.0.trigger").(string) != ""
+	if failoverTriggered && d.HasChange("partner_namespace_id") {
+		return fmt.Errorf("`partner_namespace_id` cannot be changed at the same time as a failover of %s is triggered", *id)
+	}
+
+	activeId, existing, err := resourceServiceBusNamespaceDisasterRecoveryConfigGetActive(ctx, client, d, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
 	if d.HasChange("partner_namespace_id") {
-		if _, err := client.BreakPairing(ctx, *id); err != nil {
-			return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+		if activeId != *id {
+			return fmt.Errorf("`partner_namespace_id` cannot be changed for %s since it has been failed over to %s", *id, activeId)
 		}
-		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
-			return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+
+		if resourceServiceBusNamespaceDisasterRecoveryConfigIsPaired(existing.Model) {
+			if _, err := client.BreakPairing(ctx, *id); err != nil {
+				return fmt.Errorf("breaking the pairing for %s: %+v", *id, err)
+			}
+			if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
+				return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+			}
 		}
-	}
 
-	parameters := disasterrecoveryconfigs.ArmDisasterRecovery{
-		Properties: &disasterrecoveryconfigs.ArmDisasterRecoveryProperties{
-			PartnerNamespace: utils.String(d.Get("partner_namespace_id").(string)),
-		},
-	}
+		// removing the partner namespace only breaks the pairing, the alias remains on the primary namespace
+		if partnerNamespaceId := d.Get("partner_namespace_id").(string); partnerNamespaceId != "" {
+			parameters := disasterrecoveryconfigs.ArmDisasterRecovery{
+				Properties: &disasterrecoveryconfigs.ArmDisasterRecoveryProperties{
+					PartnerNamespace: utils.String(partnerNamespaceId),
+				},
+			}
 
-	if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", *id, err)
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("creating/updating %s: %+v", *id, err)
+			}
+			if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
+				return fmt.Errorf("waiting for %s to finish replicating: %+v", *id, err)
+			}
+		}
 	}
-	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
-		return fmt.Errorf("waiting for %s to finish replicating: %+v", *id, err)
+
+	if failoverTriggered {
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigFailover(ctx, d, meta, *id, activeId); err != nil {
+			return err
+		}
 	}
 
 	return resourceServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
@@ -187,7 +243,7 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resource
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id)
+	activeId, resp, err := resourceServiceBusNamespaceDisasterRecoveryConfigGetActive(ctx, client, d, *id)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
@@ -197,18 +253,29 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resource
 	}
 
 	primaryId := disasterrecoveryconfigs.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+	activeNamespaceId := disasterrecoveryconfigs.NewNamespaceID(activeId.SubscriptionId, activeId.ResourceGroupName, activeId.NamespaceName)
 
 	d.Set("name", id.DisasterRecoveryConfigName)
 	d.Set("primary_namespace_id", primaryId.ID())
+	d.Set("active_namespace_id", activeNamespaceId.ID())
 
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
-			d.Set("partner_namespace_id", props.PartnerNamespace)
+			// once failed over the pairing is broken, the configured partner namespace is kept so that the failover doesn't cause a diff
+			if activeId == *id {
+				d.Set("partner_namespace_id", props.PartnerNamespace)
+			}
+
+			role := ""
+			if props.Role != nil {
+				role = string(*props.Role)
+			}
+			d.Set("role", role)
 		}
 	}
 
 	// the auth rule cannot be retrieved by dr config name, the shared access policy should either be specified by user or using the default one which is `RootManageSharedAccessKey`
-	authRuleId := disasterrecoveryconfigs.NewDisasterRecoveryConfigAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.DisasterRecoveryConfigName, serviceBusNamespaceDefaultAuthorizationRule)
+	authRuleId := disasterrecoveryconfigs.NewDisasterRecoveryConfigAuthorizationRuleID(activeId.SubscriptionId, activeId.ResourceGroupName, activeId.NamespaceName, activeId.DisasterRecoveryConfigName, serviceBusNamespaceDefaultAuthorizationRule)
 	if input := d.Get("alias_authorization_rule_id").(string); input != "" {
 		ruleId, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigAuthorizationRuleID(input)
 		if err != nil {
@@ -238,19 +305,30 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resour
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(d.Id())
+	configuredId, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(d.Id())
 	if err != nil {
 		return err
 	}
 
+	activeId, existing, err := resourceServiceBusNamespaceDisasterRecoveryConfigGetActive(ctx, client, d, *configuredId)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *configuredId, err)
+	}
+	id := &activeId
+
 	// @tombuildsstuff: whilst we previously checked the 200 response, since that's the only valid status
 	// code defined in the Swagger, anything else would raise an error thus the check is superfluous
-	if _, err := client.BreakPairing(ctx, *id); err != nil {
-		return fmt.Errorf("breaking pairing %s: %+v", id, err)
-	}
+	if resourceServiceBusNamespaceDisasterRecoveryConfigIsPaired(existing.Model) {
+		if _, err := client.BreakPairing(ctx, *id); err != nil {
+			return fmt.Errorf("breaking pairing %s: %+v", id, err)
+		}
 
-	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
-		return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, *id); err != nil {
+			return fmt.Errorf("waiting for the pairing to break for %s: %+v", *id, err)
+		}
 	}
 
 	if _, err := client.Delete(ctx, *id); err != nil {
@@ -326,6 +404,85 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resour
 	return nil
 }
 
+func resourceServiceBusNamespaceDisasterRecoveryConfigFailover(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id disasterrecoveryconfigs.DisasterRecoveryConfigId, activeId disasterrecoveryconfigs.DisasterRecoveryConfigId) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+
+	if activeId != id {
+		return fmt.Errorf("%s has already been failed over to %s", id, activeId)
+	}
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	props := existing.Model.Properties
+
+	if props.Role == nil || *props.Role != disasterrecoveryconfigs.RoleDisasterRecoveryPrimary || props.PartnerNamespace == nil || *props.PartnerNamespace == "" {
+		return fmt.Errorf("a failover can only be triggered when %s is paired with a partner namespace", id)
+	}
+
+	partnerNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(*props.PartnerNamespace)
+	if err != nil {
+		return err
+	}
+
+	// the failover is initiated from the secondary namespace, which then becomes the primary namespace for the alias
+	secondaryId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+
+	locks.ByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+
+	parameters := disasterrecoveryconfigs.FailoverProperties{
+		Properties: &disasterrecoveryconfigs.FailoverPropertiesProperties{
+			IsSafeFailover: utils.Bool(d.Get("failover.0.safe_failover_enabled").(bool)),
+		},
+	}
+
+	if _, err := client.FailOver(ctx, secondaryId, parameters); err != nil {
+		return fmt.Errorf("failing over %s to %s: %+v", id, secondaryId, err)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, secondaryId); err != nil {
+		return fmt.Errorf("waiting for the failover of %s to %s: %+v", id, secondaryId, err)
+	}
+
+	return nil
+}
+
+// resourceServiceBusNamespaceDisasterRecoveryConfigGetActive retrieves the alias from the namespace currently holding it.
+// The resource ID always refers to the configured primary namespace, however once a failover has been triggered the
+// alias is held by the former partner namespace instead.
+func resourceServiceBusNamespaceDisasterRecoveryConfigGetActive(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, d *pluginsdk.ResourceData, id disasterrecoveryconfigs.DisasterRecoveryConfigId) (disasterrecoveryconfigs.DisasterRecoveryConfigId, disasterrecoveryconfigs.GetOperationResponse, error) {
+	resp, err := client.Get(ctx, id)
+	if err == nil || !response.WasNotFound(resp.HttpResponse) {
+		return id, resp, err
+	}
+
+	if len(d.Get("failover").([]interface{})) == 0 {
+		return id, resp, err
+	}
+
+	partnerNamespaceId, parseErr := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(d.Get("partner_namespace_id").(string))
+	if parseErr != nil {
+		return id, resp, err
+	}
+
+	failedOverId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+	failedOverResp, err := client.Get(ctx, failedOverId)
+	return failedOverId, failedOverResp, err
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryConfigIsPaired(model *disasterrecoveryconfigs.ArmDisasterRecovery) bool {
+	if model == nil || model.Properties == nil || model.Properties.Role == nil {
+		return false
+	}
+
+	return *model.Properties.Role != disasterrecoveryconfigs.RoleDisasterRecoveryPrimaryNotReplicating
+}
+
 func resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, id disasterrecoveryconfigs.DisasterRecoveryConfigId) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -32,12 +32,76 @@ func TestAccAzureRMServiceBusNamespacePairing_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespacePairing_breakPairing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "pairing_test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.unpaired(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("PrimaryNotReplicating"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMServiceBusNamespacePairing_failover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_config", "pairing_test")
+	r := ServiceBusNamespaceDisasterRecoveryConfigResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.failover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("PrimaryNotReplicating"),
+				check.That(data.ResourceName).Key("active_namespace_id").MatchesOtherKey(
+					check.That("azurerm_servicebus_namespace.secondary_namespace_test").Key("id"),
+				),
+			),
+		},
+	})
+}
+
 func (t ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	// after a failover the alias is held by the namespace in `active_namespace_id`
+	if v := state.Attributes["active_namespace_id"]; v != "" {
+		activeNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(v)
+		if err != nil {
+			return nil, err
+		}
+		activeId := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(activeNamespaceId.SubscriptionId, activeNamespaceId.ResourceGroupName, activeNamespaceId.NamespaceName, id.DisasterRecoveryConfigName)
+		id = &activeId
+	}
+
 	resp, err := clients.ServiceBus.DisasterRecoveryConfigsClient.Get(ctx, *id)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -46,7 +110,47 @@ func (t ServiceBusNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Co
 	return utils.Bool(resp.Model != nil), nil
 }
 
-func (ServiceBusNamespaceDisasterRecoveryConfigResource) basic(data acceptance.TestData) string {
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%d"
+  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) unpaired(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%d"
+  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id = ""
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ServiceBusNamespaceDisasterRecoveryConfigResource) failover(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
+  name                 = "acctest-alias-%d"
+  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
+  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
+
+  failover {
+    trigger = "first"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ServiceBusNamespaceDisasterRecoveryConfigResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -79,11 +183,5 @@ resource "azurerm_servicebus_namespace" "secondary_namespace_test" {
   capacity                     = "1"
   premium_messaging_partitions = 1
 }
-
-resource "azurerm_servicebus_namespace_disaster_recovery_config" "pairing_test" {
-  name                 = "acctest-alias-%[1]d"
-  primary_namespace_id = azurerm_servicebus_namespace.primary_namespace_test.id
-  partner_namespace_id = azurerm_servicebus_namespace.secondary_namespace_test.id
-}
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
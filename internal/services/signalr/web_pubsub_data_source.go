@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -49,6 +49,11 @@ func dataSourceWebPubsub() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"public_port": {
 				Type:     pluginsdk.TypeInt,
 				Computed: true,
@@ -149,7 +154,7 @@ func dataSourceWebPubsubRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", id.ResourceGroupName)
 
 	if model := resp.Model; model != nil {
-		d.Set("location", location.NormalizeNilable(model.Location))
+		d.Set("location", location.Normalize(model.Location))
 
 		skuName := ""
 		skuCapacity := int64(0)
@@ -160,6 +165,12 @@ func dataSourceWebPubsubRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("sku", skuName)
 		d.Set("capacity", skuCapacity)
 
+		kind := string(webpubsub.ServiceKindWebPubSub)
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
 		if props := model.Properties; props != nil {
 			d.Set("external_ip", props.ExternalIP)
 			d.Set("hostname", props.HostName)
@@ -16,7 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -53,6 +53,15 @@ func resourceWebPubSub() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// `socket_io` is Computed, so the config is checked rather than the planned value which includes what's in state
+			socketIO := d.GetRawConfig().GetAttr("socket_io")
+			if !socketIO.IsNull() && socketIO.IsKnown() && socketIO.LengthInt() > 0 && d.Get("kind").(string) != string(webpubsub.ServiceKindSocketIO) {
+				return fmt.Errorf("`socket_io` can only be specified when `kind` is `%s`", webpubsub.ServiceKindSocketIO)
+			}
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -83,6 +92,34 @@ func resourceWebPubSub() *pluginsdk.Resource {
 				ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 200,
 					300, 400, 500, 600, 700, 800, 900, 1000})},
 
+			"kind": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(webpubsub.ServiceKindWebPubSub),
+				ValidateFunc: validation.StringInSlice(webpubsub.PossibleValuesForServiceKind(), false),
+			},
+
+			"socket_io": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service_mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "Default",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Default",
+								"Serverless",
+							}, false),
+						},
+					},
+				},
+			},
+
 			"live_trace": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -232,13 +269,15 @@ func resourceWebPubSubCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	parameters := webpubsub.WebPubSubResource{
-		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Location: location.Normalize(d.Get("location").(string)),
 		Identity: identity,
+		Kind:     pointer.To(webpubsub.ServiceKind(d.Get("kind").(string))),
 		Properties: &webpubsub.WebPubSubProperties{
 			LiveTraceConfiguration: expandLiveTraceConfig(liveTraceConfig),
 			PublicNetworkAccess:    utils.String(publicNetworkAcc),
 			DisableAadAuth:         utils.Bool(!d.Get("aad_auth_enabled").(bool)),
 			DisableLocalAuth:       utils.Bool(!d.Get("local_auth_enabled").(bool)),
+			SocketIO:               expandWebPubSubSocketIOSettings(d.Get("socket_io").([]interface{})),
 			Tls: &webpubsub.WebPubSubTlsSettings{
 				ClientCertEnabled: utils.Bool(d.Get("tls_client_cert_enabled").(bool)),
 			},
@@ -309,7 +348,7 @@ func resourceWebPubSubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("resource_group_name", id.ResourceGroupName)
 
 	if model := resp.Model; model != nil {
-		d.Set("location", location.NormalizeNilable(model.Location))
+		d.Set("location", location.Normalize(model.Location))
 
 		skuName := ""
 		skuCapacity := int64(0)
@@ -320,6 +359,12 @@ func resourceWebPubSubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("sku", skuName)
 		d.Set("capacity", skuCapacity)
 
+		kind := string(webpubsub.ServiceKindWebPubSub)
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
 		if props := model.Properties; props != nil {
 			d.Set("external_ip", props.ExternalIP)
 			d.Set("hostname", props.HostName)
@@ -355,6 +400,10 @@ func resourceWebPubSubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				return fmt.Errorf("setting `live_trace`:%+v", err)
 			}
 
+			if err := d.Set("socket_io", flattenWebPubSubSocketIOSettings(props.SocketIO)); err != nil {
+				return fmt.Errorf("setting `socket_io`: %+v", err)
+			}
+
 			identity, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
 			if err != nil {
 				return fmt.Errorf("flattening `identity`: %+v", err)
@@ -399,6 +448,33 @@ func resourceWebPubSubDelete(d *pluginsdk.ResourceData, meta interface{}) error
 	return nil
 }
 
+func expandWebPubSubSocketIOSettings(input []interface{}) *webpubsub.WebPubSubSocketIOSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	serviceMode := "Default"
+	if v, ok := input[0].(map[string]interface{}); ok {
+		serviceMode = v["service_mode"].(string)
+	}
+
+	return &webpubsub.WebPubSubSocketIOSettings{
+		ServiceMode: pointer.To(serviceMode),
+	}
+}
+
+func flattenWebPubSubSocketIOSettings(input *webpubsub.WebPubSubSocketIOSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"service_mode": pointer.From(input.ServiceMode),
+		},
+	}
+}
+
 func expandLiveTraceConfig(input []interface{}) *webpubsub.LiveTraceConfiguration {
 	resourceCategories := make([]webpubsub.LiveTraceCategory, 0)
 	if len(input) == 0 || input[0] == nil {
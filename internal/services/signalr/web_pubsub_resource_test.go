@@ -9,7 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -279,6 +279,30 @@ func TestAccWebPubsub_identity(t *testing.T) {
 	})
 }
 
+func TestAccWebPubsub_socketIO(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub", "test")
+	r := WebPubsubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.socketIO(data, "Default"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("SocketIO"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.socketIO(data, "Serverless"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("socket_io.0.service_mode").HasValue("Serverless"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r WebPubsubResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := webpubsub.ParseWebPubSubID(state.ID)
 	if err != nil {
@@ -350,6 +374,25 @@ resource "azurerm_web_pubsub" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r WebPubsubResource) socketIO(data acceptance.TestData, serviceMode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub" "test" {
+  name                = "acctestWebPubsub-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard_S1"
+  capacity            = 1
+  kind                = "SocketIO"
+
+  socket_io {
+    service_mode = "%s"
+  }
+}
+`, r.template(data), data.RandomInteger, serviceMode)
+}
+
 func (r WebPubsubResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Storage Containers and File Shares can either be managed through the Data Plane (when `storage_account_name` is
+// specified) or exclusively through the Storage Resource Provider (when `storage_account_id` is specified), the latter
+// allowing these to be managed from machines without network access to the Storage Account endpoints.
+//
+// Switching between the two for the same Storage Account only changes how the resource is identified, so the
+// resources migrate their ID in-place during an Update rather than being recreated.
+
+// storageAccountIdCustomizeDiff forces a new resource only when `storage_account_id` points at a different Storage
+// Account to the one the resource currently lives in.
+func storageAccountIdCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("storage_account_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("storage_account_id") || !d.NewValueKnown("storage_account_name") {
+		return d.ForceNew("storage_account_id")
+	}
+
+	oldRaw, newRaw := d.GetChange("storage_account_id")
+	oldAccountId, newAccountId := oldRaw.(string), newRaw.(string)
+	accountName := d.Get("storage_account_name").(string)
+
+	switch {
+	case oldAccountId != "" && newAccountId != "":
+		return d.ForceNew("storage_account_id")
+
+	case oldAccountId == "":
+		// moving from the Data Plane to the Resource Manager, `storage_account_name` holds the current account
+		id, err := commonids.ParseStorageAccountID(newAccountId)
+		if err != nil {
+			return err
+		}
+		if id.StorageAccountName != accountName {
+			return d.ForceNew("storage_account_id")
+		}
+
+	default:
+		// moving from the Resource Manager to the Data Plane
+		id, err := commonids.ParseStorageAccountID(oldAccountId)
+		if err != nil {
+			return err
+		}
+		if id.StorageAccountName != accountName {
+			return d.ForceNew("storage_account_id")
+		}
+	}
+
+	return nil
+}
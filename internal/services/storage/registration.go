@@ -82,7 +82,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		AccountQueuePropertiesResource{},
+		AccountStaticWebsiteResource{},
 		LocalUserResource{},
+		StorageAccountMigrationResource{},
 		StorageContainerImmutabilityPolicyResource{},
 		SyncServerEndpointResource{},
 	}
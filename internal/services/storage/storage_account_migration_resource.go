@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/accountmigrations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageAccountMigrationResource struct{}
+
+var _ sdk.Resource = StorageAccountMigrationResource{}
+
+type StorageAccountMigrationResourceModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	TargetSkuName    string `tfschema:"target_sku_name"`
+}
+
+func (r StorageAccountMigrationResource) ResourceType() string {
+	return "azurerm_storage_account_migration"
+}
+
+func (r StorageAccountMigrationResource) ModelObject() interface{} {
+	return &StorageAccountMigrationResourceModel{}
+}
+
+func (r StorageAccountMigrationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r StorageAccountMigrationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"target_sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(accountmigrations.PossibleValuesForSkuName(), false),
+		},
+	}
+}
+
+func (r StorageAccountMigrationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageAccountMigrationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// a redundancy migration is performed by Azure in the background and can take up to 72 hours
+		Timeout: 72 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			accountsClient := metadata.Client.Storage.ResourceManager.StorageAccounts
+			client := metadata.Client.Storage.ResourceManager.AccountMigrations
+
+			var model StorageAccountMigrationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := accountsClient.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account.Model != nil && account.Model.Sku != nil && strings.EqualFold(string(account.Model.Sku.Name), model.TargetSkuName) {
+				return fmt.Errorf("%s already has a SKU of %q", *id, model.TargetSkuName)
+			}
+
+			existing, err := client.StorageAccountsGetCustomerInitiatedMigration(ctx, *id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for an existing migration for %s: %+v", *id, err)
+			}
+			if r.migrationInProgress(existing.Model) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := accountmigrations.StorageAccountMigration{
+				Properties: accountmigrations.StorageAccountMigrationProperties{
+					TargetSkuName: accountmigrations.SkuName(model.TargetSkuName),
+				},
+			}
+
+			if err := client.StorageAccountsCustomerInitiatedMigrationThenPoll(ctx, *id, input); err != nil {
+				return fmt.Errorf("migrating %s to %q: %+v", *id, model.TargetSkuName, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountMigrationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			accountsClient := metadata.Client.Storage.ResourceManager.StorageAccounts
+			client := metadata.Client.Storage.ResourceManager.AccountMigrations
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := accountsClient.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(account.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageAccountMigrationResourceModel{
+				StorageAccountId: id.ID(),
+			}
+
+			if account.Model != nil && account.Model.Sku != nil {
+				state.TargetSkuName = string(account.Model.Sku.Name)
+			}
+
+			// whilst a migration is in progress the Storage Account retains its original SKU, so we use the target
+			// of the migration - once it's completed (or failed) the SKU of the Storage Account is authoritative
+			migration, err := client.StorageAccountsGetCustomerInitiatedMigration(ctx, *id)
+			if err != nil && !response.WasNotFound(migration.HttpResponse) {
+				return fmt.Errorf("retrieving migration for %s: %+v", *id, err)
+			}
+			if r.migrationInProgress(migration.Model) {
+				state.TargetSkuName = string(migration.Model.Properties.TargetSkuName)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountMigrationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a migration can't be reverted or cancelled, as such this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountMigrationResource) migrationInProgress(input *accountmigrations.StorageAccountMigration) bool {
+	if input == nil {
+		return false
+	}
+
+	status := pointer.From(input.Properties.MigrationStatus)
+	return status == accountmigrations.MigrationStatusInProgress || status == accountmigrations.MigrationStatusSubmittedForConversion
+}
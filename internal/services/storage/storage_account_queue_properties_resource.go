@@ -0,0 +1,463 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/queue/queues"
+)
+
+type AccountQueuePropertiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = AccountQueuePropertiesResource{}
+
+type AccountQueuePropertiesResourceModel struct {
+	StorageAccountId string                          `tfschema:"storage_account_id"`
+	CorsRule         []AccountQueuePropertiesCors    `tfschema:"cors_rule"`
+	Logging          []AccountQueuePropertiesLogging `tfschema:"logging"`
+	HourMetrics      []AccountQueuePropertiesMetrics `tfschema:"hour_metrics"`
+	MinuteMetrics    []AccountQueuePropertiesMetrics `tfschema:"minute_metrics"`
+}
+
+type AccountQueuePropertiesCors struct {
+	AllowedOrigins  []string `tfschema:"allowed_origins"`
+	ExposedHeaders  []string `tfschema:"exposed_headers"`
+	AllowedHeaders  []string `tfschema:"allowed_headers"`
+	AllowedMethods  []string `tfschema:"allowed_methods"`
+	MaxAgeInSeconds int64    `tfschema:"max_age_in_seconds"`
+}
+
+type AccountQueuePropertiesLogging struct {
+	Version             string `tfschema:"version"`
+	Delete              bool   `tfschema:"delete"`
+	Read                bool   `tfschema:"read"`
+	Write               bool   `tfschema:"write"`
+	RetentionPolicyDays int64  `tfschema:"retention_policy_days"`
+}
+
+type AccountQueuePropertiesMetrics struct {
+	Version             string `tfschema:"version"`
+	Enabled             bool   `tfschema:"enabled"`
+	IncludeAPIs         bool   `tfschema:"include_apis"`
+	RetentionPolicyDays int64  `tfschema:"retention_policy_days"`
+}
+
+func (r AccountQueuePropertiesResource) ResourceType() string {
+	return "azurerm_storage_account_queue_properties"
+}
+
+func (r AccountQueuePropertiesResource) ModelObject() interface{} {
+	return &AccountQueuePropertiesResourceModel{}
+}
+
+func (r AccountQueuePropertiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r AccountQueuePropertiesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"cors_rule": helpers.SchemaStorageAccountCorsRule(false),
+
+		"logging": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"delete": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"read": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"write": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"retention_policy_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+				},
+			},
+		},
+
+		"hour_metrics": r.metricsSchema(),
+
+		"minute_metrics": r.metricsSchema(),
+	}
+}
+
+func (r AccountQueuePropertiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AccountQueuePropertiesResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			for _, key := range []string{"hour_metrics", "minute_metrics"} {
+				if !diff.Get(key+".0.enabled").(bool) && diff.Get(key+".0.include_apis").(bool) {
+					return fmt.Errorf("`%s.0.include_apis` may only be set when `%s.0.enabled` is true", key, key)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model AccountQueuePropertiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", *id)
+			}
+
+			queueClient, err := storageClient.QueuesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			// the Queue Service Properties always exist for a Storage Account (and Azure enables some of them by
+			// default), as such there's no meaningful requires import check which can be done here.
+			if err = queueClient.UpdateServiceProperties(ctx, r.expand(model)); err != nil {
+				return fmt.Errorf("updating Queue Properties for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			queueClient, err := storageClient.QueuesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			props, err := queueClient.GetServiceProperties(ctx)
+			if err != nil {
+				return fmt.Errorf("retrieving Queue Properties for %s: %+v", *id, err)
+			}
+
+			state := r.flatten(props)
+			state.StorageAccountId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AccountQueuePropertiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", *id)
+			}
+
+			queueClient, err := storageClient.QueuesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			if err = queueClient.UpdateServiceProperties(ctx, r.expand(model)); err != nil {
+				return fmt.Errorf("updating Queue Properties for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				// the Storage Account has gone, and the Queue Properties with it
+				return nil
+			}
+
+			queueClient, err := storageClient.QueuesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			// the Queue Properties can't be removed, so we reset them to their disabled values instead
+			if err = queueClient.UpdateServiceProperties(ctx, r.expand(AccountQueuePropertiesResourceModel{})); err != nil {
+				return fmt.Errorf("resetting Queue Properties for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) metricsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"enabled": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+
+				"include_apis": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+
+				"retention_policy_days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func (r AccountQueuePropertiesResource) expand(input AccountQueuePropertiesResourceModel) queues.StorageServiceProperties {
+	properties := queues.StorageServiceProperties{
+		Cors: &queues.Cors{
+			CorsRule: []queues.CorsRule{},
+		},
+		HourMetrics:   r.expandMetrics(input.HourMetrics),
+		MinuteMetrics: r.expandMetrics(input.MinuteMetrics),
+		Logging: &queues.LoggingConfig{
+			Version: "1.0",
+		},
+	}
+
+	for _, rule := range input.CorsRule {
+		properties.Cors.CorsRule = append(properties.Cors.CorsRule, queues.CorsRule{
+			AllowedOrigins:  strings.Join(rule.AllowedOrigins, ","),
+			ExposedHeaders:  strings.Join(rule.ExposedHeaders, ","),
+			AllowedHeaders:  strings.Join(rule.AllowedHeaders, ","),
+			AllowedMethods:  strings.Join(rule.AllowedMethods, ","),
+			MaxAgeInSeconds: int(rule.MaxAgeInSeconds),
+		})
+	}
+
+	if len(input.Logging) > 0 {
+		logging := input.Logging[0]
+		properties.Logging = &queues.LoggingConfig{
+			Version: logging.Version,
+			Delete:  logging.Delete,
+			Read:    logging.Read,
+			Write:   logging.Write,
+		}
+		if logging.RetentionPolicyDays > 0 {
+			properties.Logging.RetentionPolicy = queues.RetentionPolicy{
+				Days:    int(logging.RetentionPolicyDays),
+				Enabled: true,
+			}
+		}
+	}
+
+	return properties
+}
+
+func (r AccountQueuePropertiesResource) expandMetrics(input []AccountQueuePropertiesMetrics) *queues.MetricsConfig {
+	if len(input) == 0 {
+		return &queues.MetricsConfig{
+			Version: "1.0",
+		}
+	}
+
+	metrics := &queues.MetricsConfig{
+		Version: input[0].Version,
+		Enabled: input[0].Enabled,
+	}
+
+	if input[0].Enabled {
+		metrics.IncludeAPIs = &input[0].IncludeAPIs
+	}
+
+	if input[0].RetentionPolicyDays > 0 {
+		metrics.RetentionPolicy = queues.RetentionPolicy{
+			Days:    int(input[0].RetentionPolicyDays),
+			Enabled: true,
+		}
+	}
+
+	return metrics
+}
+
+func (r AccountQueuePropertiesResource) flatten(input *queues.StorageServiceProperties) AccountQueuePropertiesResourceModel {
+	output := AccountQueuePropertiesResourceModel{}
+	if input == nil {
+		return output
+	}
+
+	if input.Cors != nil {
+		for _, rule := range input.Cors.CorsRule {
+			if rule.AllowedOrigins == "" {
+				continue
+			}
+
+			output.CorsRule = append(output.CorsRule, AccountQueuePropertiesCors{
+				AllowedOrigins:  strings.Split(rule.AllowedOrigins, ","),
+				ExposedHeaders:  strings.Split(rule.ExposedHeaders, ","),
+				AllowedHeaders:  strings.Split(rule.AllowedHeaders, ","),
+				AllowedMethods:  strings.Split(rule.AllowedMethods, ","),
+				MaxAgeInSeconds: int64(rule.MaxAgeInSeconds),
+			})
+		}
+	}
+
+	if logging := input.Logging; logging != nil && logging.Version != "" {
+		item := AccountQueuePropertiesLogging{
+			Version: logging.Version,
+			Delete:  logging.Delete,
+			Read:    logging.Read,
+			Write:   logging.Write,
+		}
+		if logging.RetentionPolicy.Enabled {
+			item.RetentionPolicyDays = int64(logging.RetentionPolicy.Days)
+		}
+		output.Logging = []AccountQueuePropertiesLogging{item}
+	}
+
+	output.HourMetrics = r.flattenMetrics(input.HourMetrics)
+	output.MinuteMetrics = r.flattenMetrics(input.MinuteMetrics)
+
+	return output
+}
+
+func (r AccountQueuePropertiesResource) flattenMetrics(input *queues.MetricsConfig) []AccountQueuePropertiesMetrics {
+	if input == nil || input.Version == "" {
+		return []AccountQueuePropertiesMetrics{}
+	}
+
+	item := AccountQueuePropertiesMetrics{
+		Version: input.Version,
+		Enabled: input.Enabled,
+	}
+	if input.IncludeAPIs != nil {
+		item.IncludeAPIs = *input.IncludeAPIs
+	}
+	if input.RetentionPolicy.Enabled {
+		item.RetentionPolicyDays = int64(input.RetentionPolicy.Days)
+	}
+
+	return []AccountQueuePropertiesMetrics{item}
+}
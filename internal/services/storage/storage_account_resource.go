@@ -807,6 +807,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 			"static_website": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -1306,6 +1307,17 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
+				// both blocks are Optional + Computed, so only the values in the config are checked here
+				if client, ok := v.(*clients.Client); ok && !client.Features.Storage.DataPlaneAvailable {
+					if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+						for _, block := range []string{"queue_properties", "static_website"} {
+							if raw := rawConfig.AsValueMap()[block]; !raw.IsNull() && (!raw.IsKnown() || raw.LengthInt() > 0) {
+								return fmt.Errorf("`%s` cannot be configured when the `data_plane_available` feature is disabled", block)
+							}
+						}
+					}
+				}
+
 				return nil
 			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
@@ -2319,6 +2331,10 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 	supportLevel := resolveStorageAccountServiceSupportLevel(resp.Kind, tier, d.Get("account_replication_type").(string))
 
+	// the Queue and Static Website properties are only available via the Data Plane, which may not be reachable
+	// (for example when the Storage Account is only accessible via a Private Endpoint)
+	dataPlaneAvailable := meta.(*clients.Client).Features.Storage.DataPlaneAvailable
+
 	if supportLevel.supportBlob {
 		blobClient := storageClient.BlobServicesClient
 		blobProps, err := blobClient.GetServiceProperties(ctx, id.ResourceGroupName, id.StorageAccountName)
@@ -2330,7 +2346,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if supportLevel.supportQueue {
+	if supportLevel.supportQueue && dataPlaneAvailable {
 		queueClient, err := storageClient.QueuesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
 		if err != nil {
 			return fmt.Errorf("building Queues Client: %s", err)
@@ -2359,7 +2375,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if supportLevel.supportStaticWebsite {
+	if supportLevel.supportStaticWebsite && dataPlaneAvailable {
 		accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
 		if err != nil {
 			return fmt.Errorf("building Accounts Data Plane Client: %s", err)
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/accounts"
+)
+
+type AccountStaticWebsiteResource struct{}
+
+var _ sdk.ResourceWithUpdate = AccountStaticWebsiteResource{}
+
+type AccountStaticWebsiteResourceModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	Error404Document string `tfschema:"error_404_document"`
+	IndexDocument    string `tfschema:"index_document"`
+}
+
+func (r AccountStaticWebsiteResource) ResourceType() string {
+	return "azurerm_storage_account_static_website"
+}
+
+func (r AccountStaticWebsiteResource) ModelObject() interface{} {
+	return &AccountStaticWebsiteResourceModel{}
+}
+
+func (r AccountStaticWebsiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateStorageAccountID
+}
+
+func (r AccountStaticWebsiteResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"error_404_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"index_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AccountStaticWebsiteResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model AccountStaticWebsiteResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", *id)
+			}
+
+			if account.Kind != storageaccounts.KindStorageVTwo && account.Kind != storageaccounts.KindBlockBlobStorage {
+				return fmt.Errorf("static websites are only supported for Storage Accounts of kind %q and %q, got %q", storageaccounts.KindStorageVTwo, storageaccounts.KindBlockBlobStorage, account.Kind)
+			}
+
+			accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+			}
+
+			existing, err := accountsClient.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("checking for existing static website for %s: %+v", *id, err)
+			}
+			if existing.StaticWebsite != nil && existing.StaticWebsite.Enabled {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := accounts.StorageServiceProperties{
+				StaticWebsite: &accounts.StaticWebsite{
+					Enabled:              true,
+					ErrorDocument404Path: model.Error404Document,
+					IndexDocument:        model.IndexDocument,
+				},
+			}
+
+			if _, err = accountsClient.SetServiceProperties(ctx, id.StorageAccountName, props); err != nil {
+				return fmt.Errorf("enabling static website for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+			}
+
+			props, err := accountsClient.GetServiceProperties(ctx, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving static website for %s: %+v", *id, err)
+			}
+
+			if props.StaticWebsite == nil || !props.StaticWebsite.Enabled {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := AccountStaticWebsiteResourceModel{
+				StorageAccountId: id.ID(),
+				Error404Document: props.StaticWebsite.ErrorDocument404Path,
+				IndexDocument:    props.StaticWebsite.IndexDocument,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AccountStaticWebsiteResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", *id)
+			}
+
+			accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+			}
+
+			props := accounts.StorageServiceProperties{
+				StaticWebsite: &accounts.StaticWebsite{
+					Enabled:              true,
+					ErrorDocument404Path: model.Error404Document,
+					IndexDocument:        model.IndexDocument,
+				},
+			}
+
+			if _, err = accountsClient.SetServiceProperties(ctx, id.StorageAccountName, props); err != nil {
+				return fmt.Errorf("updating static website for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AccountStaticWebsiteResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := commonids.ParseStorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if account == nil {
+				// the Storage Account has gone, and the static website with it
+				return nil
+			}
+
+			accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+			}
+
+			props := accounts.StorageServiceProperties{
+				StaticWebsite: &accounts.StaticWebsite{
+					Enabled: false,
+				},
+			}
+
+			if _, err = accountsClient.SetServiceProperties(ctx, id.StorageAccountName, props); err != nil {
+				return fmt.Errorf("disabling static website for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -4,11 +4,15 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
@@ -30,6 +34,9 @@ func resourceStorageContainer() *pluginsdk.Resource {
 		Update: resourceStorageContainerUpdate,
 
 		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+			if _, err := commonids.ParseStorageContainerID(id); err == nil {
+				return nil
+			}
 			_, err := containers.ParseContainerID(id, storageDomainSuffix)
 			return err
 		}),
@@ -56,9 +63,18 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"storage_account_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.StorageAccountName,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
 			},
 
 			"container_access_type": {
@@ -106,6 +122,8 @@ func resourceStorageContainer() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(storageAccountIdCustomizeDiff),
 	}
 }
 
@@ -115,6 +133,10 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if accountId := d.Get("storage_account_id").(string); accountId != "" {
+		return resourceStorageContainerCreateResourceManager(ctx, d, meta, accountId)
+	}
+
 	containerName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
 	accessLevelRaw := d.Get("container_access_type").(string)
@@ -188,6 +210,16 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if d.HasChange("storage_account_id") {
+		if err := resourceStorageContainerMigrateId(ctx, d, meta); err != nil {
+			return err
+		}
+	}
+
+	if resourceManagerId, err := commonids.ParseStorageContainerID(d.Id()); err == nil {
+		return resourceStorageContainerUpdateResourceManager(ctx, d, meta, *resourceManagerId)
+	}
+
 	id, err := containers.ParseContainerID(d.Id(), storageClient.StorageDomainSuffix)
 	if err != nil {
 		return err
@@ -247,6 +279,10 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if resourceManagerId, err := commonids.ParseStorageContainerID(d.Id()); err == nil {
+		return resourceStorageContainerReadResourceManager(ctx, d, meta, *resourceManagerId)
+	}
+
 	id, err := containers.ParseContainerID(d.Id(), storageClient.StorageDomainSuffix)
 	if err != nil {
 		return err
@@ -304,6 +340,13 @@ func resourceStorageContainerDelete(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if resourceManagerId, err := commonids.ParseStorageContainerID(d.Id()); err == nil {
+		if _, err := storageClient.ResourceManager.BlobContainers.Delete(ctx, *resourceManagerId); err != nil {
+			return fmt.Errorf("deleting %s: %+v", *resourceManagerId, err)
+		}
+		return nil
+	}
+
 	id, err := containers.ParseContainerID(d.Id(), storageClient.StorageDomainSuffix)
 	if err != nil {
 		return err
@@ -329,6 +372,180 @@ func resourceStorageContainerDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceStorageContainerCreateResourceManager(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, storageAccountId string) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.BlobContainers
+
+	accountId, err := commonids.ParseStorageAccountID(storageAccountId)
+	if err != nil {
+		return err
+	}
+
+	id := commonids.NewStorageContainerID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_container", id.ID())
+	}
+
+	payload := blobcontainers.BlobContainer{
+		Properties: &blobcontainers.ContainerProperties{
+			PublicAccess: pointer.To(expandStorageContainerPublicAccess(d.Get("container_access_type").(string))),
+			Metadata:     pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{}))),
+		},
+	}
+
+	if encryptionScope := d.Get("default_encryption_scope").(string); encryptionScope != "" {
+		payload.Properties.DefaultEncryptionScope = pointer.To(encryptionScope)
+		payload.Properties.DenyEncryptionScopeOverride = pointer.To(!d.Get("encryption_scope_override_enabled").(bool))
+	}
+
+	log.Printf("[INFO] Creating %s", id)
+	if _, err := client.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageContainerReadResourceManager(ctx, d, meta, id)
+}
+
+func resourceStorageContainerReadResourceManager(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id commonids.StorageContainerId) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.BlobContainers
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.ContainerName)
+	d.Set("storage_account_name", id.StorageAccountName)
+	d.Set("storage_account_id", commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID())
+	d.Set("resource_manager_id", id.ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("container_access_type", flattenStorageContainerPublicAccess(props.PublicAccess))
+			d.Set("default_encryption_scope", pointer.From(props.DefaultEncryptionScope))
+			d.Set("encryption_scope_override_enabled", !pointer.From(props.DenyEncryptionScopeOverride))
+			d.Set("has_immutability_policy", pointer.From(props.HasImmutabilityPolicy))
+			d.Set("has_legal_hold", pointer.From(props.HasLegalHold))
+
+			if err = d.Set("metadata", FlattenMetaData(pointer.From(props.Metadata))); err != nil {
+				return fmt.Errorf("setting `metadata`: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageContainerUpdateResourceManager(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id commonids.StorageContainerId) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.BlobContainers
+
+	if d.HasChanges("container_access_type", "metadata") {
+		payload := blobcontainers.BlobContainer{
+			Properties: &blobcontainers.ContainerProperties{},
+		}
+
+		if d.HasChange("container_access_type") {
+			payload.Properties.PublicAccess = pointer.To(expandStorageContainerPublicAccess(d.Get("container_access_type").(string)))
+		}
+
+		if d.HasChange("metadata") {
+			payload.Properties.Metadata = pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{})))
+		}
+
+		if _, err := client.Update(ctx, id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	}
+
+	return resourceStorageContainerReadResourceManager(ctx, d, meta, id)
+}
+
+// resourceStorageContainerMigrateId switches the ID of the Container between the Data Plane and Resource Manager
+// formats when `storage_account_id` is added or removed for the same Storage Account
+func resourceStorageContainerMigrateId(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+
+	containerName := d.Get("name").(string)
+
+	if storageAccountId := d.Get("storage_account_id").(string); storageAccountId != "" {
+		accountId, err := commonids.ParseStorageAccountID(storageAccountId)
+		if err != nil {
+			return err
+		}
+
+		id := commonids.NewStorageContainerID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, containerName)
+		log.Printf("[DEBUG] Migrating the ID of Container %q to %s", containerName, id)
+		d.SetId(id.ID())
+		return nil
+	}
+
+	accountName := d.Get("storage_account_name").(string)
+	account, err := storageClient.FindAccount(ctx, subscriptionId, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Container %q: %v", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating Storage Account %q", accountName)
+	}
+
+	endpoint, err := account.DataPlaneEndpoint(client.EndpointTypeBlob)
+	if err != nil {
+		return fmt.Errorf("determining Blob endpoint: %v", err)
+	}
+
+	accountId, err := accounts.ParseAccountID(*endpoint, storageClient.StorageDomainSuffix)
+	if err != nil {
+		return fmt.Errorf("parsing Account ID: %v", err)
+	}
+
+	id := containers.NewContainerID(*accountId, containerName)
+	log.Printf("[DEBUG] Migrating the ID of Container %q to %s", containerName, id)
+	d.SetId(id.ID())
+
+	return nil
+}
+
+func expandStorageContainerPublicAccess(input string) blobcontainers.PublicAccess {
+	// the Resource Manager API uses `None` and title-cased values where the Data Plane uses an empty string / lower-case
+	switch input {
+	case string(containers.Blob):
+		return blobcontainers.PublicAccessBlob
+	case string(containers.Container):
+		return blobcontainers.PublicAccessContainer
+	}
+
+	return blobcontainers.PublicAccessNone
+}
+
+func flattenStorageContainerPublicAccess(input *blobcontainers.PublicAccess) string {
+	if input == nil {
+		return "private"
+	}
+
+	switch *input {
+	case blobcontainers.PublicAccessBlob:
+		return string(containers.Blob)
+	case blobcontainers.PublicAccessContainer:
+		return string(containers.Container)
+	}
+
+	return "private"
+}
+
 func expandStorageContainerAccessLevel(input string) containers.AccessLevel {
 	// for historical reasons, "private" above is an empty string in the API
 	// so the enum doesn't 1:1 match. You could argue the SDK should handle this
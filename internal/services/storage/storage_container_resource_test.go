@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -201,7 +202,70 @@ func TestAccStorageContainer_web(t *testing.T) {
 	})
 }
 
+func TestAccStorageContainer_resourceManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceManager(data, "private", "value1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_account_name").HasValue("acctestacc"+data.RandomString),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManager(data, "container", "value2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container_access_type").HasValue("container"),
+				check.That(data.ResourceName).Key("metadata.test").HasValue("value2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainer_migrateToResourceManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.update(data, "private", "value1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManager(data, "private", "value1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("id").MatchesOtherKey(check.That(data.ResourceName).Key("resource_manager_id")),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data, "private", "value1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageContainerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if resourceManagerId, err := commonids.ParseStorageContainerID(state.ID); err == nil {
+		resp, err := client.Storage.ResourceManager.BlobContainers.Get(ctx, *resourceManagerId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *resourceManagerId, err)
+		}
+		return utils.Bool(resp.Model != nil), nil
+	}
+
 	id, err := containers.ParseContainerID(state.ID, client.Storage.StorageDomainSuffix)
 	if err != nil {
 		return nil, err
@@ -426,6 +490,23 @@ resource "azurerm_storage_container" "test" {
 `, template)
 }
 
+func (r StorageContainerResource) resourceManager(data acceptance.TestData, accessType, metadataVal string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "%s"
+  metadata = {
+    foo  = "bar"
+    test = "%s"
+  }
+}
+`, template, accessType, metadataVal)
+}
+
 func (r StorageContainerResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
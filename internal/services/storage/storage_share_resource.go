@@ -4,10 +4,16 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/fileshares"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -31,6 +37,9 @@ func resourceStorageShare() *pluginsdk.Resource {
 		Delete: resourceStorageShareDelete,
 
 		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+			if _, err := fileshares.ParseShareID(id); err == nil {
+				return nil
+			}
 			_, err := shares.ParseShareID(id, storageDomainSuffix)
 			return err
 		}),
@@ -57,9 +66,18 @@ func resourceStorageShare() *pluginsdk.Resource {
 			},
 
 			"storage_account_name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
 			},
 
 			"quota": {
@@ -141,6 +159,8 @@ func resourceStorageShare() *pluginsdk.Resource {
 					}, false),
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(storageAccountIdCustomizeDiff),
 	}
 }
 
@@ -150,6 +170,10 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if accountId := d.Get("storage_account_id").(string); accountId != "" {
+		return resourceStorageShareCreateResourceManager(ctx, d, meta, accountId)
+	}
+
 	accountName := d.Get("storage_account_name").(string)
 	shareName := d.Get("name").(string)
 	quota := d.Get("quota").(int)
@@ -236,6 +260,10 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if resourceManagerId, err := fileshares.ParseShareID(d.Id()); err == nil {
+		return resourceStorageShareReadResourceManager(ctx, d, meta, *resourceManagerId)
+	}
+
 	id, err := shares.ParseShareID(d.Id(), storageClient.StorageDomainSuffix)
 	if err != nil {
 		return err
@@ -299,6 +327,16 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if d.HasChange("storage_account_id") {
+		if err := resourceStorageShareMigrateId(ctx, d, meta); err != nil {
+			return err
+		}
+	}
+
+	if resourceManagerId, err := fileshares.ParseShareID(d.Id()); err == nil {
+		return resourceStorageShareUpdateResourceManager(ctx, d, meta, *resourceManagerId)
+	}
+
 	id, err := shares.ParseShareID(d.Id(), storageClient.StorageDomainSuffix)
 	if err != nil {
 		return err
@@ -375,6 +413,13 @@ func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if resourceManagerId, err := fileshares.ParseShareID(d.Id()); err == nil {
+		if _, err := storageClient.ResourceManager.FileShares.Delete(ctx, *resourceManagerId, fileshares.DefaultDeleteOperationOptions()); err != nil {
+			return fmt.Errorf("deleting %s: %+v", *resourceManagerId, err)
+		}
+		return nil
+	}
+
 	id, err := shares.ParseShareID(d.Id(), storageClient.StorageDomainSuffix)
 	if err != nil {
 		return err
@@ -401,6 +446,233 @@ func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceStorageShareCreateResourceManager(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, storageAccountId string) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.FileShares
+
+	accountId, err := commonids.ParseStorageAccountID(storageAccountId)
+	if err != nil {
+		return err
+	}
+
+	id := fileshares.NewShareID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id, fileshares.DefaultGetOperationOptions())
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_share", id.ID())
+	}
+
+	payload := fileshares.FileShare{
+		Properties: &fileshares.FileShareProperties{
+			EnabledProtocols:  pointer.To(fileshares.EnabledProtocols(d.Get("enabled_protocol").(string))),
+			Metadata:          pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{}))),
+			ShareQuota:        pointer.To(int64(d.Get("quota").(int))),
+			SignedIdentifiers: pointer.To(expandStorageShareSignedIdentifiers(d.Get("acl").(*pluginsdk.Set).List())),
+		},
+	}
+
+	if accessTier := d.Get("access_tier").(string); accessTier != "" {
+		payload.Properties.AccessTier = pointer.To(fileshares.ShareAccessTier(accessTier))
+	}
+
+	log.Printf("[INFO] Creating %s", id)
+	if _, err := client.Create(ctx, id, payload, fileshares.DefaultCreateOperationOptions()); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageShareReadResourceManager(ctx, d, meta, id)
+}
+
+func resourceStorageShareReadResourceManager(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id fileshares.ShareId) error {
+	storageClient := meta.(*clients.Client).Storage
+
+	resp, err := storageClient.ResourceManager.FileShares.Get(ctx, id, fileshares.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+
+	// the URL is built from the endpoint exposed by the Resource Manager so that no Data Plane calls are required
+	account, err := storageClient.ResourceManager.StorageAccounts.GetProperties(ctx, accountId, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+	url := ""
+	if model := account.Model; model != nil && model.Properties != nil && model.Properties.PrimaryEndpoints != nil {
+		if endpoint := model.Properties.PrimaryEndpoints.File; endpoint != nil {
+			url = strings.TrimSuffix(*endpoint, "/") + "/" + id.ShareName
+		}
+	}
+
+	d.Set("name", id.ShareName)
+	d.Set("storage_account_name", id.StorageAccountName)
+	d.Set("storage_account_id", accountId.ID())
+	d.Set("url", url)
+	d.Set("resource_manager_id", parse.NewStorageShareResourceManagerID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, "default", id.ShareName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("quota", int(pointer.From(props.ShareQuota)))
+			d.Set("enabled_protocol", string(pointer.From(props.EnabledProtocols)))
+			d.Set("access_tier", string(pointer.From(props.AccessTier)))
+
+			if err := d.Set("acl", flattenStorageShareSignedIdentifiers(props.SignedIdentifiers)); err != nil {
+				return fmt.Errorf("flattening `acl`: %+v", err)
+			}
+
+			if err := d.Set("metadata", FlattenMetaData(pointer.From(props.Metadata))); err != nil {
+				return fmt.Errorf("flattening `metadata`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageShareUpdateResourceManager(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id fileshares.ShareId) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.FileShares
+
+	if d.HasChanges("quota", "metadata", "acl", "access_tier") {
+		payload := fileshares.FileShare{
+			Properties: &fileshares.FileShareProperties{},
+		}
+
+		if d.HasChange("quota") {
+			payload.Properties.ShareQuota = pointer.To(int64(d.Get("quota").(int)))
+		}
+
+		if d.HasChange("metadata") {
+			payload.Properties.Metadata = pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{})))
+		}
+
+		if d.HasChange("acl") {
+			payload.Properties.SignedIdentifiers = pointer.To(expandStorageShareSignedIdentifiers(d.Get("acl").(*pluginsdk.Set).List()))
+		}
+
+		if d.HasChange("access_tier") {
+			payload.Properties.AccessTier = pointer.To(fileshares.ShareAccessTier(d.Get("access_tier").(string)))
+		}
+
+		if _, err := client.Update(ctx, id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	}
+
+	return resourceStorageShareReadResourceManager(ctx, d, meta, id)
+}
+
+// resourceStorageShareMigrateId switches the ID of the Share between the Data Plane and Resource Manager
+// formats when `storage_account_id` is added or removed for the same Storage Account
+func resourceStorageShareMigrateId(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+
+	shareName := d.Get("name").(string)
+
+	if storageAccountId := d.Get("storage_account_id").(string); storageAccountId != "" {
+		accountId, err := commonids.ParseStorageAccountID(storageAccountId)
+		if err != nil {
+			return err
+		}
+
+		id := fileshares.NewShareID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, shareName)
+		log.Printf("[DEBUG] Migrating the ID of Share %q to %s", shareName, id)
+		d.SetId(id.ID())
+		return nil
+	}
+
+	accountName := d.Get("storage_account_name").(string)
+	account, err := storageClient.FindAccount(ctx, subscriptionId, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %v", accountName, shareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating Storage Account %q", accountName)
+	}
+
+	endpoint, err := account.DataPlaneEndpoint(client.EndpointTypeFile)
+	if err != nil {
+		return fmt.Errorf("determining File endpoint: %v", err)
+	}
+
+	accountId, err := accounts.ParseAccountID(*endpoint, storageClient.StorageDomainSuffix)
+	if err != nil {
+		return fmt.Errorf("parsing Account ID: %v", err)
+	}
+
+	id := shares.NewShareID(*accountId, shareName)
+	log.Printf("[DEBUG] Migrating the ID of Share %q to %s", shareName, id)
+	d.SetId(id.ID())
+
+	return nil
+}
+
+func expandStorageShareSignedIdentifiers(input []interface{}) []fileshares.SignedIdentifier {
+	results := make([]fileshares.SignedIdentifier, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		identifier := fileshares.SignedIdentifier{
+			Id: pointer.To(vals["id"].(string)),
+		}
+
+		if policies := vals["access_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+			policy := policies[0].(map[string]interface{})
+			identifier.AccessPolicy = &fileshares.AccessPolicy{
+				Permission: pointer.To(policy["permissions"].(string)),
+			}
+			if start := policy["start"].(string); start != "" {
+				identifier.AccessPolicy.StartTime = pointer.To(start)
+			}
+			if expiry := policy["expiry"].(string); expiry != "" {
+				identifier.AccessPolicy.ExpiryTime = pointer.To(expiry)
+			}
+		}
+
+		results = append(results, identifier)
+	}
+
+	return results
+}
+
+func flattenStorageShareSignedIdentifiers(input *[]fileshares.SignedIdentifier) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		accessPolicies := make([]interface{}, 0)
+		if policy := v.AccessPolicy; policy != nil {
+			accessPolicies = append(accessPolicies, map[string]interface{}{
+				"start":       pointer.From(policy.StartTime),
+				"expiry":      pointer.From(policy.ExpiryTime),
+				"permissions": pointer.From(policy.Permission),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":            pointer.From(v.Id),
+			"access_policy": accessPolicies,
+		})
+	}
+
+	return result
+}
+
 func expandStorageShareACLs(input []interface{}) []shares.SignedIdentifier {
 	results := make([]shares.SignedIdentifier, 0)
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/fileshares"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -270,7 +271,69 @@ func TestAccStorageShare_protocolUpdate(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_resourceManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceManager(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("url").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManager(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("quota").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageShare_migrateToResourceManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManager(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_account_name").HasValue("acctestacc"+data.RandomString),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if resourceManagerId, err := fileshares.ParseShareID(state.ID); err == nil {
+		resp, err := client.Storage.ResourceManager.FileShares.Get(ctx, *resourceManagerId, fileshares.DefaultGetOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *resourceManagerId, err)
+		}
+		return utils.Bool(resp.Model != nil), nil
+	}
+
 	id, err := shares.ParseShareID(state.ID, client.Storage.StorageDomainSuffix)
 	if err != nil {
 		return nil, err
@@ -335,6 +398,19 @@ resource "azurerm_storage_share" "test" {
 `, template, data.RandomString)
 }
 
+func (r StorageShareResource) resourceManager(data acceptance.TestData, quota int) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name               = "testshare%s"
+  storage_account_id = azurerm_storage_account.test.id
+  quota              = %d
+}
+`, template, data.RandomString, quota)
+}
+
 func (r StorageShareResource) metaData(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
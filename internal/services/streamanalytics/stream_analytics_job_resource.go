@@ -165,6 +165,7 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 							Default:  string(streamingjobs.AuthenticationModeConnectionString),
 							ValidateFunc: validation.StringInSlice([]string{
 								string(streamingjobs.AuthenticationModeConnectionString),
+								string(streamingjobs.AuthenticationModeMsi),
 							}, false),
 						},
 
@@ -176,7 +177,7 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 
 						"account_key": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
@@ -315,7 +316,14 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 
 	if contentStoragePolicy == string(streamingjobs.ContentStoragePolicyJobStorageAccount) {
 		if v, ok := d.GetOk("job_storage_account"); ok {
-			props.Properties.JobStorageAccount = expandJobStorageAccount(v.([]interface{}))
+			jobStorageAccount, err := expandJobStorageAccount(v.([]interface{}))
+			if err != nil {
+				return err
+			}
+			if pointer.From(jobStorageAccount.AuthenticationMode) == streamingjobs.AuthenticationModeMsi && expandedIdentity == nil {
+				return fmt.Errorf("an `identity` block must be specified when `job_storage_account.0.authentication_mode` is `Msi`")
+			}
+			props.Properties.JobStorageAccount = jobStorageAccount
 		} else {
 			return fmt.Errorf("`job_storage_account` must be set when `content_storage_policy` is `JobStorageAccount`")
 		}
@@ -531,9 +539,9 @@ func resourceStreamAnalyticsJobDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
-func expandJobStorageAccount(input []interface{}) *streamingjobs.JobStorageAccount {
+func expandJobStorageAccount(input []interface{}) (*streamingjobs.JobStorageAccount, error) {
 	if input == nil {
-		return nil
+		return nil, nil
 	}
 
 	v := input[0].(map[string]interface{})
@@ -541,11 +549,24 @@ func expandJobStorageAccount(input []interface{}) *streamingjobs.JobStorageAccou
 	accountName := v["account_name"].(string)
 	accountKey := v["account_key"].(string)
 
-	return &streamingjobs.JobStorageAccount{
+	jobStorageAccount := &streamingjobs.JobStorageAccount{
 		AuthenticationMode: pointer.To(streamingjobs.AuthenticationMode(authenticationMode)),
 		AccountName:        utils.String(accountName),
-		AccountKey:         utils.String(accountKey),
 	}
+
+	switch streamingjobs.AuthenticationMode(authenticationMode) {
+	case streamingjobs.AuthenticationModeConnectionString:
+		if accountKey == "" {
+			return nil, fmt.Errorf("`account_key` must be specified when `authentication_mode` is `ConnectionString`")
+		}
+		jobStorageAccount.AccountKey = utils.String(accountKey)
+	case streamingjobs.AuthenticationModeMsi:
+		if accountKey != "" {
+			return nil, fmt.Errorf("`account_key` cannot be specified when `authentication_mode` is `Msi`")
+		}
+	}
+
+	return jobStorageAccount, nil
 }
 
 func flattenJobStorageAccount(d *pluginsdk.ResourceData, input *streamingjobs.JobStorageAccount) []interface{} {
@@ -558,9 +579,14 @@ func flattenJobStorageAccount(d *pluginsdk.ResourceData, input *streamingjobs.Jo
 		accountName = *v
 	}
 
+	authenticationMode := ""
+	if v := input.AuthenticationMode; v != nil {
+		authenticationMode = string(*v)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"authentication_mode": string(*input.AuthenticationMode),
+			"authentication_mode": authenticationMode,
 			"account_name":        accountName,
 			"account_key":         d.Get("job_storage_account.0.account_key").(string),
 		},
@@ -19,6 +19,7 @@ type Client struct {
 	IntegrationRuntimeAuthKeysClient                  *synapse.IntegrationRuntimeAuthKeysClient
 	IntegrationRuntimesClient                         *synapse.IntegrationRuntimesClient
 	KeysClient                                        *synapse.KeysClient
+	PrivateEndpointConnectionsClient                  *synapse.PrivateEndpointConnectionsClient
 	PrivateLinkHubsClient                             *synapse.PrivateLinkHubsClient
 	SparkPoolClient                                   *synapse.BigDataPoolsClient
 	SqlPoolClient                                     *synapse.SQLPoolsClient
@@ -55,6 +56,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	keysClient := synapse.NewKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&keysClient.Client, o.ResourceManagerAuthorizer)
 
+	privateEndpointConnectionsClient := synapse.NewPrivateEndpointConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&privateEndpointConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	privateLinkHubsClient := synapse.NewPrivateLinkHubsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&privateLinkHubsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -118,6 +122,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		IntegrationRuntimeAuthKeysClient:                  &integrationRuntimeAuthKeysClient,
 		IntegrationRuntimesClient:                         &integrationRuntimesClient,
 		KeysClient:                                        &keysClient,
+		PrivateEndpointConnectionsClient:                  &privateEndpointConnectionsClient,
 		PrivateLinkHubsClient:                             &privateLinkHubsClient,
 		SparkPoolClient:                                   &sparkPoolClient,
 		SqlPoolClient:                                     &sqlPoolClient,
@@ -4,10 +4,13 @@
 package synapse
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -25,11 +28,13 @@ func resourceSynapseManagedPrivateEndpoint() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseManagedPrivateEndpointCreate,
 		Read:   resourceSynapseManagedPrivateEndpointRead,
+		Update: resourceSynapseManagedPrivateEndpointUpdate,
 		Delete: resourceSynapseManagedPrivateEndpointDelete,
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -66,6 +71,17 @@ func resourceSynapseManagedPrivateEndpoint() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: networkValidate.PrivateLinkSubResourceName,
 			},
+
+			"synapse_target_approval_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -123,6 +139,43 @@ func resourceSynapseManagedPrivateEndpointCreate(d *pluginsdk.ResourceData, meta
 	}
 
 	d.SetId(id.ID())
+
+	if d.Get("synapse_target_approval_enabled").(bool) {
+		if err := approveSynapseManagedPrivateEndpoint(ctx, d, meta, client, id); err != nil {
+			return err
+		}
+	}
+
+	return resourceSynapseManagedPrivateEndpointRead(d, meta)
+}
+
+func resourceSynapseManagedPrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := environment.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine Synapse domain suffix for environment %q", environment.Name)
+	}
+
+	id, err := parse.ManagedPrivateEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.ManagedPrivateEndpointsClient(id.WorkspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return fmt.Errorf("building Client for %s: %v", *id, err)
+	}
+
+	// the approval is an action against the target Workspace, disabling it afterwards leaves the connection approved
+	if d.HasChange("synapse_target_approval_enabled") && d.Get("synapse_target_approval_enabled").(bool) {
+		if err := approveSynapseManagedPrivateEndpoint(ctx, d, meta, client, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceSynapseManagedPrivateEndpointRead(d, meta)
 }
 
@@ -163,7 +216,21 @@ func resourceSynapseManagedPrivateEndpointRead(d *pluginsdk.ResourceData, meta i
 	if props := resp.Properties; props != nil {
 		d.Set("target_resource_id", props.PrivateLinkResourceID)
 		d.Set("subresource_name", props.GroupID)
+
+		connectionStatus := ""
+		if props.ConnectionState != nil && props.ConnectionState.Status != nil {
+			connectionStatus = *props.ConnectionState.Status
+		}
+		d.Set("connection_status", connectionStatus)
+	}
+
+	// this is only used during creation and can't be read back from the service, so default it for imports
+	approvalEnabled := false
+	if v, ok := d.GetOk("synapse_target_approval_enabled"); ok {
+		approvalEnabled = v.(bool)
 	}
+	d.Set("synapse_target_approval_enabled", approvalEnabled)
+
 	return nil
 }
 
@@ -193,3 +260,120 @@ func resourceSynapseManagedPrivateEndpointDelete(d *pluginsdk.ResourceData, meta
 
 	return nil
 }
+
+// approveSynapseManagedPrivateEndpoint approves the connection on the target Synapse Workspace and waits for the
+// Managed Private Endpoint to report it as approved.
+func approveSynapseManagedPrivateEndpoint(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, client *managedvirtualnetwork.ManagedPrivateEndpointsClient, id parse.ManagedPrivateEndpointId) error {
+	synapseClient := meta.(*clients.Client).Synapse
+
+	targetWorkspaceId, err := parse.WorkspaceIDInsensitively(d.Get("target_resource_id").(string))
+	if err != nil {
+		return fmt.Errorf("`synapse_target_approval_enabled` can only be used when `target_resource_id` is a Synapse Workspace: %+v", err)
+	}
+
+	if err := approveSynapseManagedPrivateEndpointConnection(ctx, *synapseClient.PrivateEndpointConnectionsClient, id, *targetWorkspaceId); err != nil {
+		return err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Approved"},
+		Refresh: func() (result interface{}, state string, err error) {
+			resp, err := client.Get(ctx, id.ManagedVirtualNetworkName, id.Name)
+			if err != nil {
+				return nil, "Error", err
+			}
+			status := "Pending"
+			if props := resp.Properties; props != nil && props.ConnectionState != nil && props.ConnectionState.Status != nil && *props.ConnectionState.Status != "" {
+				status = *props.ConnectionState.Status
+			}
+			return resp, status, nil
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the connection of %s to be approved: %+v", id, err)
+	}
+
+	return nil
+}
+
+// approveSynapseManagedPrivateEndpointConnection approves the Private Endpoint Connection which the Managed Private
+// Endpoint creates on the target Synapse Workspace. The underlying Private Endpoint is named `{workspace}.{name}`, and
+// the connection can take a short while to show up on the target after the Managed Private Endpoint has been created.
+func approveSynapseManagedPrivateEndpointConnection(ctx context.Context, client synapse.PrivateEndpointConnectionsClient, id parse.ManagedPrivateEndpointId, targetWorkspaceId parse.WorkspaceId) error {
+	// the target Workspace may live in a different Subscription
+	client.SubscriptionID = targetWorkspaceId.SubscriptionId
+
+	privateEndpointName := fmt.Sprintf("%s.%s", id.WorkspaceName, id.Name)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"NotFound"},
+		Target:  []string{"Found"},
+		Refresh: func() (result interface{}, state string, err error) {
+			connections, err := client.ListComplete(ctx, targetWorkspaceId.ResourceGroup, targetWorkspaceId.Name)
+			if err != nil {
+				return nil, "Error", fmt.Errorf("listing Private Endpoint Connections for %s: %+v", targetWorkspaceId, err)
+			}
+			for connections.NotDone() {
+				connection := connections.Value()
+				if props := connection.PrivateEndpointConnectionProperties; props != nil && props.PrivateEndpoint != nil && props.PrivateEndpoint.ID != nil {
+					segments := strings.Split(*props.PrivateEndpoint.ID, "/")
+					if strings.EqualFold(segments[len(segments)-1], privateEndpointName) {
+						return connection, "Found", nil
+					}
+				}
+				if err := connections.NextWithContext(ctx); err != nil {
+					return nil, "Error", fmt.Errorf("listing Private Endpoint Connections for %s: %+v", targetWorkspaceId, err)
+				}
+			}
+			return "", "NotFound", nil
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for the Private Endpoint Connection for %s to exist on %s: %+v", id, targetWorkspaceId, err)
+	}
+
+	connection := result.(synapse.PrivateEndpointConnection)
+	if connection.Name == nil {
+		return fmt.Errorf("retrieving the Private Endpoint Connection for %s on %s: `name` was nil", id, targetWorkspaceId)
+	}
+
+	if props := connection.PrivateEndpointConnectionProperties; props != nil && props.PrivateLinkServiceConnectionState != nil && props.PrivateLinkServiceConnectionState.Status != nil {
+		if strings.EqualFold(*props.PrivateLinkServiceConnectionState.Status, "Approved") {
+			return nil
+		}
+	}
+
+	input := synapse.PrivateEndpointConnection{
+		PrivateEndpointConnectionProperties: &synapse.PrivateEndpointConnectionProperties{
+			PrivateLinkServiceConnectionState: &synapse.PrivateLinkServiceConnectionState{
+				Status:      utils.String("Approved"),
+				Description: utils.String(fmt.Sprintf("Approved by Terraform for Managed Private Endpoint %q", id.Name)),
+			},
+		},
+	}
+	future, err := client.Create(ctx, input, targetWorkspaceId.ResourceGroup, targetWorkspaceId.Name, *connection.Name)
+	if err != nil {
+		return fmt.Errorf("approving Private Endpoint Connection %q on %s: %+v", *connection.Name, targetWorkspaceId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for approval of Private Endpoint Connection %q on %s: %+v", *connection.Name, targetWorkspaceId, err)
+	}
+
+	return nil
+}
@@ -33,6 +33,45 @@ func TestAccSynapseManagedPrivateEndpoint_basic(t *testing.T) {
 	})
 }
 
+func TestAccSynapseManagedPrivateEndpoint_synapseTargetApproval(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_managed_private_endpoint", "test")
+	r := SynapseManagedPrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.synapseTargetApproval(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_status").HasValue("Approved"),
+			),
+		},
+		data.ImportStep("synapse_target_approval_enabled"),
+	})
+}
+
+func TestAccSynapseManagedPrivateEndpoint_synapseTargetApprovalUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_managed_private_endpoint", "test")
+	r := SynapseManagedPrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.synapseTargetApproval(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("synapse_target_approval_enabled"),
+		{
+			Config: r.synapseTargetApproval(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_status").HasValue("Approved"),
+			),
+		},
+		data.ImportStep("synapse_target_approval_enabled"),
+	})
+}
+
 func TestAccSynapseManagedPrivateEndpoint_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_synapse_managed_private_endpoint", "test")
 	r := SynapseManagedPrivateEndpointResource{}
@@ -104,6 +143,41 @@ resource "azurerm_synapse_managed_private_endpoint" "import" {
 `, config)
 }
 
+func (r SynapseManagedPrivateEndpointResource) synapseTargetApproval(data acceptance.TestData, approvalEnabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "target" {
+  name               = "acctest-target-%[2]d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_synapse_workspace" "target" {
+  name                                 = "acctestswt%[2]d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.target.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_synapse_managed_private_endpoint" "test" {
+  name                            = "acctestEndpoint%[2]d"
+  synapse_workspace_id            = azurerm_synapse_workspace.test.id
+  target_resource_id              = azurerm_synapse_workspace.target.id
+  subresource_name                = "Sql"
+  synapse_target_approval_enabled = %[3]t
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+`, template, data.RandomInteger, approvalEnabled)
+}
+
 func (r SynapseManagedPrivateEndpointResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -193,13 +193,17 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 						"git_url": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.IsURLWithHTTPS,
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsURLWithHTTPS,
+							DiffSuppressFunc: suppressGitUrlTrailingSlashDiff,
 						},
+						// the last commit is updated by the service whenever changes are published from Synapse Studio,
+						// so this is Computed to avoid a diff when it isn't explicitly specified
 						"last_commit_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 						"repository_name": {
@@ -886,15 +890,18 @@ func expandWorkspaceRepositoryConfiguration(d *pluginsdk.ResourceData) *synapse.
 
 	if githubList, ok := d.GetOk("github_repo"); ok {
 		github := githubList.([]interface{})[0].(map[string]interface{})
-		return &synapse.WorkspaceRepositoryConfiguration{
+		config := synapse.WorkspaceRepositoryConfiguration{
 			Type:                utils.String(workspaceGitHubConfiguration),
 			AccountName:         utils.String(github["account_name"].(string)),
 			CollaborationBranch: utils.String(github["branch_name"].(string)),
-			HostName:            utils.String(github["git_url"].(string)),
-			LastCommitID:        utils.String(github["last_commit_id"].(string)),
+			HostName:            utils.String(strings.TrimSuffix(github["git_url"].(string), "/")),
 			RepositoryName:      utils.String(github["repository_name"].(string)),
 			RootFolder:          utils.String(github["root_folder"].(string)),
 		}
+		if lastCommitId := github["last_commit_id"].(string); lastCommitId != "" {
+			config.LastCommitID = utils.String(lastCommitId)
+		}
+		return &config
 	}
 
 	// API won't clear an existing repository config with nil
@@ -1017,6 +1024,12 @@ func flattenWorkspaceRepositoryConfiguration(config *synapse.WorkspaceRepository
 	return "", make([]interface{}, 0)
 }
 
+// suppressGitUrlTrailingSlashDiff suppresses the diff between e.g. `https://github.contoso.com/` and
+// `https://github.contoso.com`, since the host name of a GitHub Enterprise Server is returned without a trailing slash.
+func suppressGitUrlTrailingSlashDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return strings.EqualFold(strings.TrimSuffix(old, "/"), strings.TrimSuffix(new, "/"))
+}
+
 func flattenIdentityControlSQLSettings(settings synapse.ManagedIdentitySQLControlSettingsModel) bool {
 	if prop := settings.ManagedIdentitySQLControlSettingsModelProperties; prop != nil {
 		if sqlControl := prop.GrantSQLControlToManagedIdentity; sqlControl != nil {
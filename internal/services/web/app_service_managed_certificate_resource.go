@@ -6,6 +6,7 @@ package web
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
@@ -90,6 +91,21 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"previous_thumbprint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_key_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"valid": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -232,7 +248,16 @@ func resourceAppServiceManagedCertificateRead(d *pluginsdk.ResourceData, meta in
 			expirationDate = props.ExpirationDate.Format(time.RFC3339)
 		}
 		d.Set("expiration_date", expirationDate)
+
+		// Managed Certificates are renewed automatically by the service, which issues a new certificate with a new thumbprint.
+		// Keep track of the thumbprint being replaced so that consumers can detect the rotation.
+		if oldThumbprint := d.Get("thumbprint").(string); oldThumbprint != "" && props.Thumbprint != nil && !strings.EqualFold(oldThumbprint, *props.Thumbprint) {
+			log.Printf("[DEBUG] App Service Managed Certificate %q (Resource Group %q) has been renewed, thumbprint changed from %q to %q", id.CertificateName, id.ResourceGroup, oldThumbprint, *props.Thumbprint)
+			d.Set("previous_thumbprint", oldThumbprint)
+		}
 		d.Set("thumbprint", props.Thumbprint)
+		d.Set("public_key_hash", props.PublicKeyHash)
+		d.Set("valid", props.Valid)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
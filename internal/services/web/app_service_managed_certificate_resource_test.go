@@ -33,6 +33,9 @@ func TestAccAppServiceManagedCertificate_basicLinux(t *testing.T) {
 			Config: r.basicLinux(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("valid").HasValue("true"),
+				check.That(data.ResourceName).Key("public_key_hash").Exists(),
+				check.That(data.ResourceName).Key("previous_thumbprint").IsEmpty(),
 			),
 		},
 	})